@@ -4,6 +4,7 @@ go 1.22.1
 
 require (
 	github.com/hashicorp/terraform-plugin-framework v1.7.0
+	github.com/hashicorp/terraform-plugin-framework-validators v0.9.0
 	github.com/hashicorp/terraform-plugin-log v0.9.0
 )
 
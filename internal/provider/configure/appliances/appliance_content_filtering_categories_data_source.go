@@ -0,0 +1,127 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &applianceContentFilteringCategoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &applianceContentFilteringCategoriesDataSource{}
+)
+
+func NewApplianceContentFilteringCategoriesDataSource() datasource.DataSource {
+	return &applianceContentFilteringCategoriesDataSource{}
+}
+
+type applianceContentFilteringCategoriesDataSource struct {
+	client meraki.Client
+}
+
+type applianceContentFilteringCategoriesDataSourceModel struct {
+	NetworkID   types.String                             `tfsdk:"network_id"`
+	Categories  []applianceContentFilteringCategoryModel `tfsdk:"categories"`
+	CategoryIDs map[string]types.String                  `tfsdk:"category_ids"`
+}
+
+type applianceContentFilteringCategoryModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (a *applianceContentFilteringCategoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_content_filtering_categories"
+}
+
+func (a *applianceContentFilteringCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance content filtering categories data source")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance content filtering categories data source")
+}
+
+func (a *applianceContentFilteringCategoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the URL categories that can be blocked by ciscomeraki_appliance_content_filtering.",
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the appliance network",
+			},
+			"categories": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The URL categories",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the category, e.g. 'meraki:contentFiltering/category/C1'",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the category",
+						},
+					},
+				},
+			},
+			"category_ids": schema.MapAttribute{
+				Computed:    true,
+				Description: "The category IDs keyed by category name",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (a *applianceContentFilteringCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance content filtering categories data source")
+	var state applianceContentFilteringCategoriesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := a.client.GetApplianceContentFilteringCategories(state.NetworkID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get content filtering categories",
+			"failed to get content filtering categories: "+err.Error(),
+		)
+		return
+	}
+
+	state.Categories = make([]applianceContentFilteringCategoryModel, 0, len(categories))
+	state.CategoryIDs = make(map[string]types.String, len(categories))
+	for _, category := range categories {
+		state.Categories = append(state.Categories, applianceContentFilteringCategoryModel{
+			ID:   types.StringValue(category.ID),
+			Name: types.StringValue(category.Name),
+		})
+		state.CategoryIDs[category.Name] = types.StringValue(category.ID)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance content filtering categories data source")
+}
@@ -0,0 +1,258 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &applianceContentFilteringResource{}
+	_ resource.ResourceWithConfigure   = &applianceContentFilteringResource{}
+	_ resource.ResourceWithImportState = &applianceContentFilteringResource{}
+)
+
+func NewApplianceContentFilteringResource() resource.Resource {
+	return &applianceContentFilteringResource{}
+}
+
+type applianceContentFilteringResource struct {
+	client meraki.Client
+}
+
+type ApplianceContentFilteringResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	NetworkID            types.String `tfsdk:"network_id"`
+	AllowedURLPatterns   types.Set    `tfsdk:"allowed_url_patterns"`
+	BlockedURLPatterns   types.Set    `tfsdk:"blocked_url_patterns"`
+	BlockedURLCategories types.Set    `tfsdk:"blocked_url_categories"`
+	URLCategoryListSize  types.String `tfsdk:"url_category_list_size"`
+}
+
+func (a *applianceContentFilteringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_content_filtering"
+}
+
+func (a *applianceContentFilteringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the content filtering settings of an MX appliance network. All patterns and categories are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_url_patterns": schema.SetAttribute{
+				Optional:    true,
+				Description: "The URL patterns that are always allowed, e.g. 'http://www.example.org'",
+				ElementType: types.StringType,
+			},
+			"blocked_url_patterns": schema.SetAttribute{
+				Optional:    true,
+				Description: "The URL patterns that are always blocked, e.g. 'example.com'",
+				ElementType: types.StringType,
+			},
+			"blocked_url_categories": schema.SetAttribute{
+				Optional:    true,
+				Description: "The IDs of the blocked URL categories, e.g. 'meraki:contentFiltering/category/C1', see ciscomeraki_appliance_content_filtering_categories",
+				ElementType: types.StringType,
+			},
+			"url_category_list_size": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The size of the URL category list, can be 'topSites' or 'fullList'. Defaults to 'topSites'",
+				Default:     stringdefault.StaticString("topSites"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("topSites", "fullList"),
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceContentFilteringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance content filtering resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance content filtering resource")
+}
+
+func (a *applianceContentFilteringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance content filtering resource")
+	var plan ApplianceContentFilteringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filtering, diags := contentFilteringToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := a.client.UpdateApplianceContentFiltering(plan.NetworkID.ValueString(), filtering)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance content filtering", "Failed to update appliance content filtering: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	resp.Diagnostics.Append(contentFilteringFromAPI(ctx, updated, &plan)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance content filtering resource")
+}
+
+func (a *applianceContentFilteringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance content filtering resource")
+	var state ApplianceContentFilteringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filtering, err := a.client.GetApplianceContentFiltering(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance content filtering", "Failed to get appliance content filtering: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(contentFilteringFromAPI(ctx, filtering, &state)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance content filtering resource")
+}
+
+func (a *applianceContentFilteringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance content filtering resource")
+	var plan ApplianceContentFilteringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filtering, diags := contentFilteringToAPI(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := a.client.UpdateApplianceContentFiltering(plan.NetworkID.ValueString(), filtering)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance content filtering", "Failed to update appliance content filtering: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(contentFilteringFromAPI(ctx, updated, &plan)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance content filtering resource")
+}
+
+func (a *applianceContentFilteringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance content filtering resource")
+	var state ApplianceContentFilteringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceContentFiltering(state.NetworkID.ValueString(), &meraki.ApplianceContentFilteringUpdateRequest{
+		AllowedURLPatterns:   []string{},
+		BlockedURLPatterns:   []string{},
+		BlockedURLCategories: []string{},
+		URLCategoryListSize:  "topSites",
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance content filtering", "Failed to delete appliance content filtering: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance content filtering resource")
+}
+
+func (a *applianceContentFilteringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func contentFilteringToAPI(ctx context.Context, plan *ApplianceContentFilteringResourceModel) (*meraki.ApplianceContentFilteringUpdateRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	// the API keeps the current values of lists that are not sent
+	filtering := &meraki.ApplianceContentFilteringUpdateRequest{
+		AllowedURLPatterns:   []string{},
+		BlockedURLPatterns:   []string{},
+		BlockedURLCategories: []string{},
+		URLCategoryListSize:  plan.URLCategoryListSize.ValueString(),
+	}
+	diags.Append(helpers.SetToStrings(ctx, plan.AllowedURLPatterns, &filtering.AllowedURLPatterns)...)
+	diags.Append(helpers.SetToStrings(ctx, plan.BlockedURLPatterns, &filtering.BlockedURLPatterns)...)
+	diags.Append(helpers.SetToStrings(ctx, plan.BlockedURLCategories, &filtering.BlockedURLCategories)...)
+	return filtering, diags
+}
+
+func contentFilteringFromAPI(ctx context.Context, filtering *meraki.ApplianceContentFiltering, state *ApplianceContentFilteringResourceModel) diag.Diagnostics {
+	categories := make([]string, 0, len(filtering.BlockedURLCategories))
+	for _, category := range filtering.BlockedURLCategories {
+		categories = append(categories, category.ID)
+	}
+
+	var diags, d diag.Diagnostics
+	state.AllowedURLPatterns, d = helpers.SetFromStrings(ctx, state.AllowedURLPatterns, filtering.AllowedURLPatterns)
+	diags.Append(d...)
+	state.BlockedURLPatterns, d = helpers.SetFromStrings(ctx, state.BlockedURLPatterns, filtering.BlockedURLPatterns)
+	diags.Append(d...)
+	state.BlockedURLCategories, d = helpers.SetFromStrings(ctx, state.BlockedURLCategories, categories)
+	diags.Append(d...)
+	state.URLCategoryListSize = types.StringValue(filtering.URLCategoryListSize)
+	if filtering.URLCategoryListSize == "" {
+		state.URLCategoryListSize = types.StringValue("topSites")
+	}
+	return diags
+}
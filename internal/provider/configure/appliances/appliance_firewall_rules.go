@@ -0,0 +1,169 @@
+package appliances
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ApplianceFirewallRuleModel struct {
+	Comment       types.String `tfsdk:"comment"`
+	Policy        types.String `tfsdk:"policy"`
+	Protocol      types.String `tfsdk:"protocol"`
+	SrcCidr       types.String `tfsdk:"src_cidr"`
+	SrcPort       types.String `tfsdk:"src_port"`
+	DestCidr      types.String `tfsdk:"dest_cidr"`
+	DestPort      types.String `tfsdk:"dest_port"`
+	SyslogEnabled types.Bool   `tfsdk:"syslog_enabled"`
+}
+
+// firewallRuleAttributes returns the schema of a single L3 firewall rule.
+func firewallRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"comment": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "A description of the rule",
+			Default:     stringdefault.StaticString(""),
+		},
+		"policy": schema.StringAttribute{
+			Required:    true,
+			Description: "The action taken by the rule, can be 'allow' or 'deny'",
+			Validators: []validator.String{
+				stringvalidator.OneOf("allow", "deny"),
+			},
+		},
+		"protocol": schema.StringAttribute{
+			Required:    true,
+			Description: "The protocol matched by the rule, can be 'tcp', 'udp', 'icmp', 'icmp6' or 'any'",
+			Validators: []validator.String{
+				stringvalidator.OneOfCaseInsensitive("tcp", "udp", "icmp", "icmp6", "any"),
+			},
+		},
+		"src_cidr": schema.StringAttribute{
+			Required:    true,
+			Description: "Comma separated list of source IPs, CIDRs, VLAN(x).y or OBJ()/GRP() references, or 'Any'",
+			Validators: []validator.String{
+				validators.FirewallAddresses(),
+			},
+		},
+		"src_port": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Comma separated list of source ports or port ranges, or 'Any'. Only allowed for tcp and udp rules",
+			Default:     stringdefault.StaticString("Any"),
+			Validators: []validator.String{
+				validators.Ports(),
+			},
+		},
+		"dest_cidr": schema.StringAttribute{
+			Required:    true,
+			Description: "Comma separated list of destination IPs, CIDRs, VLAN(x).y or OBJ()/GRP() references, or 'Any'",
+			Validators: []validator.String{
+				validators.FirewallAddresses(),
+			},
+		},
+		"dest_port": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Comma separated list of destination ports or port ranges, or 'Any'. Only allowed for tcp and udp rules",
+			Default:     stringdefault.StaticString("Any"),
+			Validators: []validator.String{
+				validators.Ports(),
+			},
+		},
+		"syslog_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether hits of the rule are logged to syslog. Requires a syslog server on the network",
+			Default:     booldefault.StaticBool(false),
+		},
+	}
+}
+
+// validateFirewallRules checks the rules for combinations the API rejects.
+func validateFirewallRules(rulesPath path.Path, rules []ApplianceFirewallRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, rule := range rules {
+		if rule.Protocol.IsUnknown() || rule.Protocol.IsNull() {
+			continue
+		}
+		protocol := strings.ToLower(rule.Protocol.ValueString())
+		if protocol == "tcp" || protocol == "udp" {
+			continue
+		}
+		for attr, port := range map[string]types.String{"src_port": rule.SrcPort, "dest_port": rule.DestPort} {
+			if port.IsUnknown() || port.IsNull() || strings.EqualFold(port.ValueString(), "any") {
+				continue
+			}
+			diags.AddAttributeError(
+				rulesPath.AtListIndex(i).AtName(attr),
+				"Invalid firewall rule",
+				fmt.Sprintf("%s can only be set for tcp and udp rules, got protocol %q", attr, rule.Protocol.ValueString()),
+			)
+		}
+	}
+	return diags
+}
+
+func firewallRulesToAPI(rules []ApplianceFirewallRuleModel) []meraki.ApplianceFirewallRule {
+	result := make([]meraki.ApplianceFirewallRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, meraki.ApplianceFirewallRule{
+			Comment:       rule.Comment.ValueString(),
+			Policy:        rule.Policy.ValueString(),
+			Protocol:      rule.Protocol.ValueString(),
+			SrcCidr:       rule.SrcCidr.ValueString(),
+			SrcPort:       rule.SrcPort.ValueString(),
+			DestCidr:      rule.DestCidr.ValueString(),
+			DestPort:      rule.DestPort.ValueString(),
+			SyslogEnabled: rule.SyslogEnabled.ValueBool(),
+		})
+	}
+	return result
+}
+
+// firewallRulesFromAPI converts the rules returned by the API, dropping the implicit default rule
+// the API always appends. The API normalizes the case of values like "Any", so values that only
+// differ in case from the prior rule at the same position are kept as configured.
+func firewallRulesFromAPI(prior []ApplianceFirewallRuleModel, rules []meraki.ApplianceFirewallRule) []ApplianceFirewallRuleModel {
+	if len(rules) > 0 && isDefaultFirewallRule(rules[len(rules)-1]) {
+		rules = rules[:len(rules)-1]
+	}
+
+	result := make([]ApplianceFirewallRuleModel, 0, len(rules))
+	for i, rule := range rules {
+		var previous ApplianceFirewallRuleModel
+		if i < len(prior) {
+			previous = prior[i]
+		}
+		result = append(result, ApplianceFirewallRuleModel{
+			Comment:       types.StringValue(rule.Comment),
+			Policy:        helpers.KeepCase(previous.Policy, rule.Policy),
+			Protocol:      helpers.KeepCase(previous.Protocol, rule.Protocol),
+			SrcCidr:       helpers.KeepCase(previous.SrcCidr, rule.SrcCidr),
+			SrcPort:       helpers.KeepCase(previous.SrcPort, rule.SrcPort),
+			DestCidr:      helpers.KeepCase(previous.DestCidr, rule.DestCidr),
+			DestPort:      helpers.KeepCase(previous.DestPort, rule.DestPort),
+			SyslogEnabled: types.BoolValue(rule.SyslogEnabled),
+		})
+	}
+	return result
+}
+
+func isDefaultFirewallRule(rule meraki.ApplianceFirewallRule) bool {
+	return rule.Comment == "Default rule" &&
+		strings.EqualFold(rule.SrcCidr, "any") &&
+		strings.EqualFold(rule.DestCidr, "any")
+}
@@ -0,0 +1,281 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &applianceFirewallRulesResource{}
+	_ resource.ResourceWithConfigure      = &applianceFirewallRulesResource{}
+	_ resource.ResourceWithImportState    = &applianceFirewallRulesResource{}
+	_ resource.ResourceWithValidateConfig = &applianceFirewallRulesResource{}
+)
+
+// firewallRuleSet describes one of the L3 rule lists of an appliance. They share the rule
+// format and only differ in the endpoint used to manage them.
+type firewallRuleSet struct {
+	typeName    string
+	name        string
+	description string
+	// syslogDefaultRule reports whether the endpoint accepts the syslogDefaultRule setting
+	syslogDefaultRule bool
+	get               func(client meraki.Client, networkID string) (*meraki.ApplianceFirewallRules, error)
+	update            func(client meraki.Client, networkID string, rules *meraki.ApplianceFirewallRules) (*meraki.ApplianceFirewallRules, error)
+}
+
+func NewApplianceL3FirewallRulesResource() resource.Resource {
+	return &applianceFirewallRulesResource{
+		ruleSet: firewallRuleSet{
+			typeName:          "_appliance_l3_firewall_rules",
+			name:              "appliance L3 firewall rules",
+			description:       "Manages the complete, ordered list of outbound L3 firewall rules of an MX appliance network.",
+			syslogDefaultRule: true,
+			get:               meraki.Client.GetApplianceL3FirewallRules,
+			update:            meraki.Client.UpdateApplianceL3FirewallRules,
+		},
+	}
+}
+
+func NewApplianceInboundFirewallRulesResource() resource.Resource {
+	return &applianceFirewallRulesResource{
+		ruleSet: firewallRuleSet{
+			typeName:          "_appliance_inbound_firewall_rules",
+			name:              "appliance inbound firewall rules",
+			description:       "Manages the complete, ordered list of inbound L3 firewall rules of an MX appliance network.",
+			syslogDefaultRule: true,
+			get:               meraki.Client.GetApplianceInboundFirewallRules,
+			update:            meraki.Client.UpdateApplianceInboundFirewallRules,
+		},
+	}
+}
+
+func NewApplianceCellularFirewallRulesResource() resource.Resource {
+	return &applianceFirewallRulesResource{
+		ruleSet: firewallRuleSet{
+			typeName:    "_appliance_cellular_firewall_rules",
+			name:        "appliance cellular firewall rules",
+			description: "Manages the complete, ordered list of L3 firewall rules applied to the cellular uplink of an MX appliance network.",
+			get:         meraki.Client.GetApplianceCellularFirewallRules,
+			update:      meraki.Client.UpdateApplianceCellularFirewallRules,
+		},
+	}
+}
+
+type applianceFirewallRulesResource struct {
+	client  meraki.Client
+	ruleSet firewallRuleSet
+}
+
+type ApplianceFirewallRulesResourceModel struct {
+	ID                types.String                 `tfsdk:"id"`
+	NetworkID         types.String                 `tfsdk:"network_id"`
+	Rules             []ApplianceFirewallRuleModel `tfsdk:"rules"`
+	SyslogDefaultRule types.Bool                   `tfsdk:"syslog_default_rule"`
+}
+
+func (a *applianceFirewallRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + a.ruleSet.typeName
+}
+
+func (a *applianceFirewallRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	syslogDefaultRule := schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Whether hits of the default rule are logged to syslog. Requires a syslog server on the network",
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	if !a.ruleSet.syslogDefaultRule {
+		syslogDefaultRule.Optional = false
+		syslogDefaultRule.Description = "Whether hits of the default rule are logged to syslog. Read only for this rule set"
+	}
+
+	resp.Schema = schema.Schema{
+		Description: a.ruleSet.description + " The implicit default allow rule is not part of the list. All rules are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The firewall rules in the order they are evaluated",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: firewallRuleAttributes(),
+				},
+			},
+			"syslog_default_rule": syslogDefaultRule,
+		},
+	}
+}
+
+func (a *applianceFirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the "+a.ruleSet.name+" resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the "+a.ruleSet.name+" resource")
+}
+
+func (a *applianceFirewallRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceFirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateFirewallRules(path.Root("rules"), config.Rules)...)
+}
+
+func (a *applianceFirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the "+a.ruleSet.name+" resource")
+	var plan ApplianceFirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.ruleSet.update(a.client, plan.NetworkID.ValueString(), a.toAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update "+a.ruleSet.name, "Failed to update "+a.ruleSet.name+": "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	firewallRulesResourceFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the "+a.ruleSet.name+" resource")
+}
+
+func (a *applianceFirewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the "+a.ruleSet.name+" resource")
+	var state ApplianceFirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.ruleSet.get(a.client, state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get "+a.ruleSet.name, "Failed to get "+a.ruleSet.name+": "+err.Error())
+		return
+	}
+
+	firewallRulesResourceFromAPI(rules, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the "+a.ruleSet.name+" resource")
+}
+
+func (a *applianceFirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the "+a.ruleSet.name+" resource")
+	var plan ApplianceFirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.ruleSet.update(a.client, plan.NetworkID.ValueString(), a.toAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update "+a.ruleSet.name, "Failed to update "+a.ruleSet.name+": "+err.Error())
+		return
+	}
+
+	firewallRulesResourceFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the "+a.ruleSet.name+" resource")
+}
+
+func (a *applianceFirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the "+a.ruleSet.name+" resource")
+	var state ApplianceFirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.ruleSet.update(a.client, state.NetworkID.ValueString(), &meraki.ApplianceFirewallRules{
+		Rules: []meraki.ApplianceFirewallRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete "+a.ruleSet.name, "Failed to delete "+a.ruleSet.name+": "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the "+a.ruleSet.name+" resource")
+}
+
+func (a *applianceFirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func (a *applianceFirewallRulesResource) toAPI(plan *ApplianceFirewallRulesResourceModel) *meraki.ApplianceFirewallRules {
+	rules := &meraki.ApplianceFirewallRules{
+		Rules: firewallRulesToAPI(plan.Rules),
+	}
+	if a.ruleSet.syslogDefaultRule && !plan.SyslogDefaultRule.IsNull() && !plan.SyslogDefaultRule.IsUnknown() {
+		syslog := plan.SyslogDefaultRule.ValueBool()
+		rules.SyslogDefaultRule = &syslog
+	}
+	return rules
+}
+
+func firewallRulesResourceFromAPI(rules *meraki.ApplianceFirewallRules, state *ApplianceFirewallRulesResourceModel) {
+	// the syslog setting of the default rule is only reported on the default rule itself
+	syslogDefaultRule := false
+	if n := len(rules.Rules); n > 0 && isDefaultFirewallRule(rules.Rules[n-1]) {
+		syslogDefaultRule = rules.Rules[n-1].SyslogEnabled
+	}
+	if rules.SyslogDefaultRule != nil {
+		syslogDefaultRule = *rules.SyslogDefaultRule
+	}
+
+	state.Rules = firewallRulesFromAPI(state.Rules, rules.Rules)
+	state.SyslogDefaultRule = types.BoolValue(syslogDefaultRule)
+}
@@ -0,0 +1,167 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &applianceL7ApplicationCategoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &applianceL7ApplicationCategoriesDataSource{}
+)
+
+func NewApplianceL7ApplicationCategoriesDataSource() datasource.DataSource {
+	return &applianceL7ApplicationCategoriesDataSource{}
+}
+
+type applianceL7ApplicationCategoriesDataSource struct {
+	client meraki.Client
+}
+
+type applianceL7ApplicationCategoriesDataSourceModel struct {
+	NetworkID      types.String                          `tfsdk:"network_id"`
+	Categories     []applianceL7ApplicationCategoryModel `tfsdk:"categories"`
+	CategoryIDs    map[string]types.String               `tfsdk:"category_ids"`
+	ApplicationIDs map[string]types.String               `tfsdk:"application_ids"`
+}
+
+type applianceL7ApplicationCategoryModel struct {
+	ID           types.String                  `tfsdk:"id"`
+	Name         types.String                  `tfsdk:"name"`
+	Applications []applianceL7ApplicationModel `tfsdk:"applications"`
+}
+
+type applianceL7ApplicationModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (a *applianceL7ApplicationCategoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_l7_application_categories"
+}
+
+func (a *applianceL7ApplicationCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance L7 application categories data source")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance L7 application categories data source")
+}
+
+func (a *applianceL7ApplicationCategoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	applicationAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the application, e.g. 'meraki:layer7/application/67'",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the application",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the L7 application categories and applications that can be referenced by ciscomeraki_appliance_l7_firewall_rules.",
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the appliance network",
+			},
+			"categories": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The application categories and their applications",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the category, e.g. 'meraki:layer7/category/24'",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the category",
+						},
+						"applications": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The applications in the category",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: applicationAttributes,
+							},
+						},
+					},
+				},
+			},
+			"category_ids": schema.MapAttribute{
+				Computed:    true,
+				Description: "The category IDs keyed by category name",
+				ElementType: types.StringType,
+			},
+			"application_ids": schema.MapAttribute{
+				Computed:    true,
+				Description: "The application IDs keyed by application name",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (a *applianceL7ApplicationCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance L7 application categories data source")
+	var state applianceL7ApplicationCategoriesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, err := a.client.GetApplianceL7ApplicationCategories(state.NetworkID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get L7 application categories",
+			"failed to get L7 application categories: "+err.Error(),
+		)
+		return
+	}
+
+	state.Categories = make([]applianceL7ApplicationCategoryModel, 0, len(categories))
+	state.CategoryIDs = make(map[string]types.String, len(categories))
+	state.ApplicationIDs = make(map[string]types.String)
+	for _, category := range categories {
+		model := applianceL7ApplicationCategoryModel{
+			ID:           types.StringValue(category.ID),
+			Name:         types.StringValue(category.Name),
+			Applications: make([]applianceL7ApplicationModel, 0, len(category.Applications)),
+		}
+		for _, application := range category.Applications {
+			model.Applications = append(model.Applications, applianceL7ApplicationModel{
+				ID:   types.StringValue(application.ID),
+				Name: types.StringValue(application.Name),
+			})
+			state.ApplicationIDs[application.Name] = types.StringValue(application.ID)
+		}
+		state.Categories = append(state.Categories, model)
+		state.CategoryIDs[category.Name] = types.StringValue(category.ID)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance L7 application categories data source")
+}
@@ -0,0 +1,287 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &applianceL7FirewallRulesResource{}
+	_ resource.ResourceWithConfigure      = &applianceL7FirewallRulesResource{}
+	_ resource.ResourceWithImportState    = &applianceL7FirewallRulesResource{}
+	_ resource.ResourceWithValidateConfig = &applianceL7FirewallRulesResource{}
+)
+
+func NewApplianceL7FirewallRulesResource() resource.Resource {
+	return &applianceL7FirewallRulesResource{}
+}
+
+type applianceL7FirewallRulesResource struct {
+	client meraki.Client
+}
+
+type ApplianceL7FirewallRulesResourceModel struct {
+	ID        types.String                   `tfsdk:"id"`
+	NetworkID types.String                   `tfsdk:"network_id"`
+	Rules     []ApplianceL7FirewallRuleModel `tfsdk:"rules"`
+}
+
+type ApplianceL7FirewallRuleModel struct {
+	Policy    types.String   `tfsdk:"policy"`
+	Type      types.String   `tfsdk:"type"`
+	Value     types.String   `tfsdk:"value"`
+	Countries []types.String `tfsdk:"countries"`
+}
+
+func (a *applianceL7FirewallRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_l7_firewall_rules"
+}
+
+func (a *applianceL7FirewallRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete, ordered list of L7 firewall rules of an MX appliance network. All rules are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The L7 firewall rules",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The policy applied to matching traffic, only 'deny' is supported",
+							Default:     stringdefault.StaticString("deny"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("deny"),
+							},
+						},
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of the rule, can be 'application', 'applicationCategory', 'host', 'port', 'ipRange', 'blockedCountries' or 'allowedCountries'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("application", "applicationCategory", "host", "port", "ipRange", "blockedCountries", "allowedCountries"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Optional: true,
+							Description: "The host, port or IP range matched by the rule, or the ID of the application or application category, " +
+								"see the ciscomeraki_appliance_l7_application_categories data source. Not used by country rules",
+						},
+						"countries": schema.ListAttribute{
+							Optional:    true,
+							Description: "The ISO 3166-1 alpha-2 country codes of blockedCountries and allowedCountries rules",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceL7FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance L7 firewall rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance L7 firewall rules resource")
+}
+
+func (a *applianceL7FirewallRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rules {
+		if rule.Type.IsUnknown() || rule.Type.IsNull() {
+			continue
+		}
+		rulePath := path.Root("rules").AtListIndex(i)
+		switch rule.Type.ValueString() {
+		case "blockedCountries", "allowedCountries":
+			if len(rule.Countries) == 0 {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("countries"), "Missing countries", rule.Type.ValueString()+" rules require countries to be set")
+			}
+			if !rule.Value.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("value"), "Invalid L7 firewall rule", rule.Type.ValueString()+" rules use countries instead of value")
+			}
+		default:
+			if rule.Value.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("value"), "Missing value", rule.Type.ValueString()+" rules require value to be set")
+			}
+			if rule.Countries != nil {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("countries"), "Invalid L7 firewall rule", "countries can only be set for blockedCountries and allowedCountries rules")
+			}
+		}
+	}
+}
+
+func (a *applianceL7FirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance L7 firewall rules resource")
+	var plan ApplianceL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceL7FirewallRules(plan.NetworkID.ValueString(), l7FirewallRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance L7 firewall rules", "Failed to update appliance L7 firewall rules: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	plan.Rules = l7FirewallRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance L7 firewall rules resource")
+}
+
+func (a *applianceL7FirewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance L7 firewall rules resource")
+	var state ApplianceL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.GetApplianceL7FirewallRules(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance L7 firewall rules", "Failed to get appliance L7 firewall rules: "+err.Error())
+		return
+	}
+
+	state.Rules = l7FirewallRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance L7 firewall rules resource")
+}
+
+func (a *applianceL7FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance L7 firewall rules resource")
+	var plan ApplianceL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceL7FirewallRules(plan.NetworkID.ValueString(), l7FirewallRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance L7 firewall rules", "Failed to update appliance L7 firewall rules: "+err.Error())
+		return
+	}
+
+	plan.Rules = l7FirewallRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance L7 firewall rules resource")
+}
+
+func (a *applianceL7FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance L7 firewall rules resource")
+	var state ApplianceL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceL7FirewallRules(state.NetworkID.ValueString(), &meraki.ApplianceL7FirewallRules{
+		Rules: []meraki.ApplianceL7FirewallRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance L7 firewall rules", "Failed to delete appliance L7 firewall rules: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance L7 firewall rules resource")
+}
+
+func (a *applianceL7FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func l7FirewallRulesToAPI(rules []ApplianceL7FirewallRuleModel) *meraki.ApplianceL7FirewallRules {
+	result := &meraki.ApplianceL7FirewallRules{
+		Rules: make([]meraki.ApplianceL7FirewallRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		result.Rules = append(result.Rules, meraki.ApplianceL7FirewallRule{
+			Policy:    rule.Policy.ValueString(),
+			Type:      rule.Type.ValueString(),
+			Value:     rule.Value.ValueString(),
+			Countries: helpers.FromStringValues(rule.Countries),
+		})
+	}
+	return result
+}
+
+func l7FirewallRulesFromAPI(rules []meraki.ApplianceL7FirewallRule) []ApplianceL7FirewallRuleModel {
+	result := make([]ApplianceL7FirewallRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		model := ApplianceL7FirewallRuleModel{
+			Policy: types.StringValue(rule.Policy),
+			Type:   types.StringValue(rule.Type),
+			Value:  types.StringNull(),
+		}
+		switch rule.Type {
+		case "blockedCountries", "allowedCountries":
+			model.Countries = helpers.ToStringValues(rule.Countries)
+		default:
+			model.Value = types.StringValue(rule.Value)
+		}
+		result = append(result, model)
+	}
+	return result
+}
@@ -0,0 +1,318 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &applianceOneToManyNatRulesResource{}
+	_ resource.ResourceWithConfigure   = &applianceOneToManyNatRulesResource{}
+	_ resource.ResourceWithImportState = &applianceOneToManyNatRulesResource{}
+)
+
+func NewApplianceOneToManyNatRulesResource() resource.Resource {
+	return &applianceOneToManyNatRulesResource{}
+}
+
+type applianceOneToManyNatRulesResource struct {
+	client meraki.Client
+}
+
+type ApplianceOneToManyNatRulesResourceModel struct {
+	ID        types.String                     `tfsdk:"id"`
+	NetworkID types.String                     `tfsdk:"network_id"`
+	Rules     []ApplianceOneToManyNatRuleModel `tfsdk:"rules"`
+}
+
+type ApplianceOneToManyNatRuleModel struct {
+	PublicIP  types.String                         `tfsdk:"public_ip"`
+	Uplink    types.String                         `tfsdk:"uplink"`
+	PortRules []ApplianceOneToManyNatPortRuleModel `tfsdk:"port_rules"`
+}
+
+type ApplianceOneToManyNatPortRuleModel struct {
+	Name       types.String   `tfsdk:"name"`
+	Protocol   types.String   `tfsdk:"protocol"`
+	PublicPort types.String   `tfsdk:"public_port"`
+	LocalIP    types.String   `tfsdk:"local_ip"`
+	LocalPort  types.String   `tfsdk:"local_port"`
+	AllowedIPs []types.String `tfsdk:"allowed_ips"`
+}
+
+func (a *applianceOneToManyNatRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_one_to_many_nat_rules"
+}
+
+func (a *applianceOneToManyNatRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete list of 1:many NAT rules of an MX appliance network. All rules are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The 1:many NAT rules, one per public IP",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"public_ip": schema.StringAttribute{
+							Required:    true,
+							Description: "The IP address that will be used to access the internal resources from the WAN",
+							Validators: []validator.String{
+								validators.IPv4Address(),
+							},
+						},
+						"uplink": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The physical WAN interface on which the traffic will arrive, can be 'internet1' or 'internet2'. Defaults to 'internet1'",
+							Default:     stringdefault.StaticString("internet1"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("internet1", "internet2"),
+							},
+						},
+						"port_rules": schema.ListNestedAttribute{
+							Required:    true,
+							Description: "The port forwarding rules of the public IP",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "A descriptive name for the rule",
+									},
+									"protocol": schema.StringAttribute{
+										Required:    true,
+										Description: "The protocol of the forwarded traffic, can be 'tcp' or 'udp'",
+										Validators: []validator.String{
+											stringvalidator.OneOf("tcp", "udp"),
+										},
+									},
+									"public_port": schema.StringAttribute{
+										Required:    true,
+										Description: "The port forwarded to the local IP",
+										Validators: []validator.String{
+											validators.Ports(),
+										},
+									},
+									"local_ip": schema.StringAttribute{
+										Required:    true,
+										Description: "The local IP address the traffic is forwarded to",
+										Validators: []validator.String{
+											validators.IPv4Address(),
+										},
+									},
+									"local_port": schema.StringAttribute{
+										Required:    true,
+										Description: "The port on the local IP the traffic is forwarded to",
+										Validators: []validator.String{
+											validators.Ports(),
+										},
+									},
+									"allowed_ips": schema.ListAttribute{
+										Required:    true,
+										Description: "The remote IPs or CIDRs allowed to access the resource, or 'any'",
+										ElementType: types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+											listvalidator.ValueStringsAre(validators.AllowedIP()),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceOneToManyNatRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance 1:many NAT rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance 1:many NAT rules resource")
+}
+
+func (a *applianceOneToManyNatRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance 1:many NAT rules resource")
+	var plan ApplianceOneToManyNatRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceOneToManyNatRules(plan.NetworkID.ValueString(), oneToManyNatRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance 1:many NAT rules", "Failed to update appliance 1:many NAT rules: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	plan.Rules = oneToManyNatRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance 1:many NAT rules resource")
+}
+
+func (a *applianceOneToManyNatRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance 1:many NAT rules resource")
+	var state ApplianceOneToManyNatRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.GetApplianceOneToManyNatRules(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance 1:many NAT rules", "Failed to get appliance 1:many NAT rules: "+err.Error())
+		return
+	}
+
+	state.Rules = oneToManyNatRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance 1:many NAT rules resource")
+}
+
+func (a *applianceOneToManyNatRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance 1:many NAT rules resource")
+	var plan ApplianceOneToManyNatRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceOneToManyNatRules(plan.NetworkID.ValueString(), oneToManyNatRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance 1:many NAT rules", "Failed to update appliance 1:many NAT rules: "+err.Error())
+		return
+	}
+
+	plan.Rules = oneToManyNatRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance 1:many NAT rules resource")
+}
+
+func (a *applianceOneToManyNatRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance 1:many NAT rules resource")
+	var state ApplianceOneToManyNatRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceOneToManyNatRules(state.NetworkID.ValueString(), &meraki.ApplianceOneToManyNatRules{
+		Rules: []meraki.ApplianceOneToManyNatRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance 1:many NAT rules", "Failed to delete appliance 1:many NAT rules: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance 1:many NAT rules resource")
+}
+
+func (a *applianceOneToManyNatRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func oneToManyNatRulesToAPI(rules []ApplianceOneToManyNatRuleModel) *meraki.ApplianceOneToManyNatRules {
+	result := &meraki.ApplianceOneToManyNatRules{
+		Rules: make([]meraki.ApplianceOneToManyNatRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		apiRule := meraki.ApplianceOneToManyNatRule{
+			PublicIP:  rule.PublicIP.ValueString(),
+			Uplink:    rule.Uplink.ValueString(),
+			PortRules: make([]meraki.ApplianceOneToManyNatPortRule, 0, len(rule.PortRules)),
+		}
+		for _, portRule := range rule.PortRules {
+			apiRule.PortRules = append(apiRule.PortRules, meraki.ApplianceOneToManyNatPortRule{
+				Name:       portRule.Name.ValueString(),
+				Protocol:   portRule.Protocol.ValueString(),
+				PublicPort: portRule.PublicPort.ValueString(),
+				LocalIP:    portRule.LocalIP.ValueString(),
+				LocalPort:  portRule.LocalPort.ValueString(),
+				AllowedIPs: helpers.FromStringValues(portRule.AllowedIPs),
+			})
+		}
+		result.Rules = append(result.Rules, apiRule)
+	}
+	return result
+}
+
+func oneToManyNatRulesFromAPI(rules []meraki.ApplianceOneToManyNatRule) []ApplianceOneToManyNatRuleModel {
+	result := make([]ApplianceOneToManyNatRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		model := ApplianceOneToManyNatRuleModel{
+			PublicIP:  types.StringValue(rule.PublicIP),
+			Uplink:    types.StringValue(rule.Uplink),
+			PortRules: make([]ApplianceOneToManyNatPortRuleModel, 0, len(rule.PortRules)),
+		}
+		for _, portRule := range rule.PortRules {
+			model.PortRules = append(model.PortRules, ApplianceOneToManyNatPortRuleModel{
+				Name:       types.StringValue(portRule.Name),
+				Protocol:   types.StringValue(portRule.Protocol),
+				PublicPort: types.StringValue(portRule.PublicPort),
+				LocalIP:    types.StringValue(portRule.LocalIP),
+				LocalPort:  types.StringValue(portRule.LocalPort),
+				AllowedIPs: helpers.ToStringValues(portRule.AllowedIPs),
+			})
+		}
+		result = append(result, model)
+	}
+	return result
+}
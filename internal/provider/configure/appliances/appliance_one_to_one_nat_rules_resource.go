@@ -0,0 +1,317 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &applianceOneToOneNatRulesResource{}
+	_ resource.ResourceWithConfigure   = &applianceOneToOneNatRulesResource{}
+	_ resource.ResourceWithImportState = &applianceOneToOneNatRulesResource{}
+)
+
+func NewApplianceOneToOneNatRulesResource() resource.Resource {
+	return &applianceOneToOneNatRulesResource{}
+}
+
+type applianceOneToOneNatRulesResource struct {
+	client meraki.Client
+}
+
+type ApplianceOneToOneNatRulesResourceModel struct {
+	ID        types.String                    `tfsdk:"id"`
+	NetworkID types.String                    `tfsdk:"network_id"`
+	Rules     []ApplianceOneToOneNatRuleModel `tfsdk:"rules"`
+}
+
+type ApplianceOneToOneNatRuleModel struct {
+	Name           types.String                              `tfsdk:"name"`
+	PublicIP       types.String                              `tfsdk:"public_ip"`
+	LanIP          types.String                              `tfsdk:"lan_ip"`
+	Uplink         types.String                              `tfsdk:"uplink"`
+	AllowedInbound []ApplianceOneToOneNatAllowedInboundModel `tfsdk:"allowed_inbound"`
+}
+
+type ApplianceOneToOneNatAllowedInboundModel struct {
+	Protocol         types.String   `tfsdk:"protocol"`
+	DestinationPorts []types.String `tfsdk:"destination_ports"`
+	AllowedIPs       []types.String `tfsdk:"allowed_ips"`
+}
+
+func (a *applianceOneToOneNatRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_one_to_one_nat_rules"
+}
+
+func (a *applianceOneToOneNatRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete list of 1:1 NAT rules of an MX appliance network. All rules are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The 1:1 NAT rules",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "A descriptive name for the rule",
+							Default:     stringdefault.StaticString(""),
+						},
+						"public_ip": schema.StringAttribute{
+							Required:    true,
+							Description: "The IP address that will be used to access the internal resource from the WAN",
+							Validators: []validator.String{
+								validators.IPv4Address(),
+							},
+						},
+						"lan_ip": schema.StringAttribute{
+							Required:    true,
+							Description: "The IP address of the server or device that hosts the internal resource",
+							Validators: []validator.String{
+								validators.IPv4Address(),
+							},
+						},
+						"uplink": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The physical WAN interface on which the traffic will arrive, can be 'internet1' or 'internet2'. Defaults to 'internet1'",
+							Default:     stringdefault.StaticString("internet1"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("internet1", "internet2"),
+							},
+						},
+						"allowed_inbound": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "The ports and remote IPs allowed to access the internal resource",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"protocol": schema.StringAttribute{
+										Required:    true,
+										Description: "The protocol of the allowed traffic, can be 'tcp', 'udp', 'icmp-ping' or 'any'",
+										Validators: []validator.String{
+											stringvalidator.OneOf("tcp", "udp", "icmp-ping", "any"),
+										},
+									},
+									"destination_ports": schema.ListAttribute{
+										Optional:    true,
+										Description: "The ports or port ranges allowed, or 'any'. Only used by tcp and udp rules",
+										ElementType: types.StringType,
+										Validators: []validator.List{
+											listvalidator.ValueStringsAre(validators.Ports()),
+										},
+									},
+									"allowed_ips": schema.ListAttribute{
+										Required:    true,
+										Description: "The remote IPs or CIDRs allowed to access the resource, or 'any'",
+										ElementType: types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+											listvalidator.ValueStringsAre(validators.AllowedIP()),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceOneToOneNatRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance 1:1 NAT rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance 1:1 NAT rules resource")
+}
+
+func (a *applianceOneToOneNatRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance 1:1 NAT rules resource")
+	var plan ApplianceOneToOneNatRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceOneToOneNatRules(plan.NetworkID.ValueString(), oneToOneNatRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance 1:1 NAT rules", "Failed to update appliance 1:1 NAT rules: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	plan.Rules = oneToOneNatRulesFromAPI(plan.Rules, rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance 1:1 NAT rules resource")
+}
+
+func (a *applianceOneToOneNatRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance 1:1 NAT rules resource")
+	var state ApplianceOneToOneNatRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.GetApplianceOneToOneNatRules(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance 1:1 NAT rules", "Failed to get appliance 1:1 NAT rules: "+err.Error())
+		return
+	}
+
+	state.Rules = oneToOneNatRulesFromAPI(state.Rules, rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance 1:1 NAT rules resource")
+}
+
+func (a *applianceOneToOneNatRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance 1:1 NAT rules resource")
+	var plan ApplianceOneToOneNatRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceOneToOneNatRules(plan.NetworkID.ValueString(), oneToOneNatRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance 1:1 NAT rules", "Failed to update appliance 1:1 NAT rules: "+err.Error())
+		return
+	}
+
+	plan.Rules = oneToOneNatRulesFromAPI(plan.Rules, rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance 1:1 NAT rules resource")
+}
+
+func (a *applianceOneToOneNatRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance 1:1 NAT rules resource")
+	var state ApplianceOneToOneNatRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceOneToOneNatRules(state.NetworkID.ValueString(), &meraki.ApplianceOneToOneNatRules{
+		Rules: []meraki.ApplianceOneToOneNatRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance 1:1 NAT rules", "Failed to delete appliance 1:1 NAT rules: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance 1:1 NAT rules resource")
+}
+
+func (a *applianceOneToOneNatRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func oneToOneNatRulesToAPI(rules []ApplianceOneToOneNatRuleModel) *meraki.ApplianceOneToOneNatRules {
+	result := &meraki.ApplianceOneToOneNatRules{
+		Rules: make([]meraki.ApplianceOneToOneNatRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		apiRule := meraki.ApplianceOneToOneNatRule{
+			Name:           rule.Name.ValueString(),
+			PublicIP:       rule.PublicIP.ValueString(),
+			LanIP:          rule.LanIP.ValueString(),
+			Uplink:         rule.Uplink.ValueString(),
+			AllowedInbound: make([]meraki.ApplianceOneToOneNatAllowedInbound, 0, len(rule.AllowedInbound)),
+		}
+		for _, inbound := range rule.AllowedInbound {
+			apiRule.AllowedInbound = append(apiRule.AllowedInbound, meraki.ApplianceOneToOneNatAllowedInbound{
+				Protocol:         inbound.Protocol.ValueString(),
+				DestinationPorts: helpers.FromStringValues(inbound.DestinationPorts),
+				AllowedIPs:       helpers.FromStringValues(inbound.AllowedIPs),
+			})
+		}
+		result.Rules = append(result.Rules, apiRule)
+	}
+	return result
+}
+
+func oneToOneNatRulesFromAPI(prior []ApplianceOneToOneNatRuleModel, rules []meraki.ApplianceOneToOneNatRule) []ApplianceOneToOneNatRuleModel {
+	result := make([]ApplianceOneToOneNatRuleModel, 0, len(rules))
+	for i, rule := range rules {
+		model := ApplianceOneToOneNatRuleModel{
+			Name:     types.StringValue(rule.Name),
+			PublicIP: types.StringValue(rule.PublicIP),
+			LanIP:    types.StringValue(rule.LanIP),
+			Uplink:   types.StringValue(rule.Uplink),
+		}
+		// keep allowed_inbound null when it was not configured and the API reports none
+		if len(rule.AllowedInbound) > 0 || (i < len(prior) && prior[i].AllowedInbound != nil) {
+			model.AllowedInbound = make([]ApplianceOneToOneNatAllowedInboundModel, 0, len(rule.AllowedInbound))
+		}
+		for j, inbound := range rule.AllowedInbound {
+			inboundModel := ApplianceOneToOneNatAllowedInboundModel{
+				Protocol:   types.StringValue(inbound.Protocol),
+				AllowedIPs: helpers.ToStringValues(inbound.AllowedIPs),
+			}
+			if len(inbound.DestinationPorts) > 0 || (i < len(prior) && j < len(prior[i].AllowedInbound) && prior[i].AllowedInbound[j].DestinationPorts != nil) {
+				inboundModel.DestinationPorts = helpers.ToStringValues(inbound.DestinationPorts)
+			}
+			model.AllowedInbound = append(model.AllowedInbound, inboundModel)
+		}
+		result = append(result, model)
+	}
+	return result
+}
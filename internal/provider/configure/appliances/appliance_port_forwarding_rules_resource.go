@@ -0,0 +1,284 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &appliancePortForwardingRulesResource{}
+	_ resource.ResourceWithConfigure   = &appliancePortForwardingRulesResource{}
+	_ resource.ResourceWithImportState = &appliancePortForwardingRulesResource{}
+)
+
+func NewAppliancePortForwardingRulesResource() resource.Resource {
+	return &appliancePortForwardingRulesResource{}
+}
+
+type appliancePortForwardingRulesResource struct {
+	client meraki.Client
+}
+
+type AppliancePortForwardingRulesResourceModel struct {
+	ID        types.String                       `tfsdk:"id"`
+	NetworkID types.String                       `tfsdk:"network_id"`
+	Rules     []AppliancePortForwardingRuleModel `tfsdk:"rules"`
+}
+
+type AppliancePortForwardingRuleModel struct {
+	Name       types.String   `tfsdk:"name"`
+	LanIP      types.String   `tfsdk:"lan_ip"`
+	PublicPort types.String   `tfsdk:"public_port"`
+	LocalPort  types.String   `tfsdk:"local_port"`
+	AllowedIPs []types.String `tfsdk:"allowed_ips"`
+	Protocol   types.String   `tfsdk:"protocol"`
+	Uplink     types.String   `tfsdk:"uplink"`
+}
+
+func (a *appliancePortForwardingRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_port_forwarding_rules"
+}
+
+func (a *appliancePortForwardingRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete list of port forwarding rules of an MX appliance network. All rules are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The port forwarding rules",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "A descriptive name for the rule",
+						},
+						"lan_ip": schema.StringAttribute{
+							Required:    true,
+							Description: "The IP address of the server or device that hosts the service",
+							Validators: []validator.String{
+								validators.IPv4Address(),
+							},
+						},
+						"public_port": schema.StringAttribute{
+							Required:    true,
+							Description: "The port or port range forwarded to the host on the LAN",
+							Validators: []validator.String{
+								validators.Ports(),
+							},
+						},
+						"local_port": schema.StringAttribute{
+							Required:    true,
+							Description: "The port or port range that receives the forwarded traffic from the WAN",
+							Validators: []validator.String{
+								validators.Ports(),
+							},
+						},
+						"allowed_ips": schema.ListAttribute{
+							Required:    true,
+							Description: "The remote IPs or CIDRs allowed to access the service, or 'any'",
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(validators.AllowedIP()),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Required:    true,
+							Description: "The protocol of the forwarded traffic, can be 'tcp' or 'udp'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("tcp", "udp"),
+							},
+						},
+						"uplink": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The uplink the rule applies to, can be 'internet1', 'internet2' or 'both'. Defaults to 'both'",
+							Default:     stringdefault.StaticString("both"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("internet1", "internet2", "both"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *appliancePortForwardingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance port forwarding rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance port forwarding rules resource")
+}
+
+func (a *appliancePortForwardingRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance port forwarding rules resource")
+	var plan AppliancePortForwardingRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateAppliancePortForwardingRules(plan.NetworkID.ValueString(), portForwardingRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance port forwarding rules", "Failed to update appliance port forwarding rules: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	plan.Rules = portForwardingRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance port forwarding rules resource")
+}
+
+func (a *appliancePortForwardingRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance port forwarding rules resource")
+	var state AppliancePortForwardingRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.GetAppliancePortForwardingRules(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance port forwarding rules", "Failed to get appliance port forwarding rules: "+err.Error())
+		return
+	}
+
+	state.Rules = portForwardingRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance port forwarding rules resource")
+}
+
+func (a *appliancePortForwardingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance port forwarding rules resource")
+	var plan AppliancePortForwardingRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateAppliancePortForwardingRules(plan.NetworkID.ValueString(), portForwardingRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance port forwarding rules", "Failed to update appliance port forwarding rules: "+err.Error())
+		return
+	}
+
+	plan.Rules = portForwardingRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance port forwarding rules resource")
+}
+
+func (a *appliancePortForwardingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance port forwarding rules resource")
+	var state AppliancePortForwardingRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateAppliancePortForwardingRules(state.NetworkID.ValueString(), &meraki.AppliancePortForwardingRules{
+		Rules: []meraki.AppliancePortForwardingRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance port forwarding rules", "Failed to delete appliance port forwarding rules: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance port forwarding rules resource")
+}
+
+func (a *appliancePortForwardingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func portForwardingRulesToAPI(rules []AppliancePortForwardingRuleModel) *meraki.AppliancePortForwardingRules {
+	result := &meraki.AppliancePortForwardingRules{
+		Rules: make([]meraki.AppliancePortForwardingRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		result.Rules = append(result.Rules, meraki.AppliancePortForwardingRule{
+			Name:       rule.Name.ValueString(),
+			LanIP:      rule.LanIP.ValueString(),
+			PublicPort: rule.PublicPort.ValueString(),
+			LocalPort:  rule.LocalPort.ValueString(),
+			AllowedIPs: helpers.FromStringValues(rule.AllowedIPs),
+			Protocol:   rule.Protocol.ValueString(),
+			Uplink:     rule.Uplink.ValueString(),
+		})
+	}
+	return result
+}
+
+func portForwardingRulesFromAPI(rules []meraki.AppliancePortForwardingRule) []AppliancePortForwardingRuleModel {
+	result := make([]AppliancePortForwardingRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, AppliancePortForwardingRuleModel{
+			Name:       types.StringValue(rule.Name),
+			LanIP:      types.StringValue(rule.LanIP),
+			PublicPort: types.StringValue(rule.PublicPort),
+			LocalPort:  types.StringValue(rule.LocalPort),
+			AllowedIPs: helpers.ToStringValues(rule.AllowedIPs),
+			Protocol:   types.StringValue(rule.Protocol),
+			Uplink:     types.StringValue(rule.Uplink),
+		})
+	}
+	return result
+}
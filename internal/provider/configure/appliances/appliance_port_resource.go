@@ -0,0 +1,412 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+)
+
+var (
+	_ resource.Resource                   = &appliancePortResource{}
+	_ resource.ResourceWithConfigure      = &appliancePortResource{}
+	_ resource.ResourceWithImportState    = &appliancePortResource{}
+	_ resource.ResourceWithValidateConfig = &appliancePortResource{}
+)
+
+func NewAppliancePortResource() resource.Resource {
+	return &appliancePortResource{}
+}
+
+type appliancePortResource struct {
+	client meraki.Client
+}
+
+type AppliancePortResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	NetworkID           types.String `tfsdk:"network_id"`
+	PortNumber          types.Int64  `tfsdk:"port_number"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	Type                types.String `tfsdk:"type"`
+	DropUntaggedTraffic types.Bool   `tfsdk:"drop_untagged_traffic"`
+	Vlan                types.Int64  `tfsdk:"vlan"`
+	AllowedVlans        types.String `tfsdk:"allowed_vlans"`
+	AccessPolicy        types.String `tfsdk:"access_policy"`
+}
+
+func (a *appliancePortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_port"
+}
+
+func (a *appliancePortResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the configuration of a LAN port of an MX appliance. VLANs must be enabled on the network, and the port keeps its last configuration on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/port_number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port_number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the appliance port",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the port is enabled. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The type of the port, can be 'access' or 'trunk'. Defaults to 'access'",
+				Default:     stringdefault.StaticString("access"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("access", "trunk"),
+				},
+			},
+			"drop_untagged_traffic": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether untagged traffic is dropped, only applies to trunk ports. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"vlan": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The VLAN of an access port, or the native VLAN of a trunk port",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"allowed_vlans": schema.StringAttribute{
+				Optional:    true,
+				Description: "The VLANs allowed on a trunk port, either 'all' or a comma separated list of VLAN IDs and ranges, e.g. '1,3,5-10'",
+				Validators: []validator.String{
+					validators.VlanList(),
+				},
+			},
+			"access_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The access policy of an access port, can be 'open', '8021x-radius', 'mac-radius' or 'hybris-radius'. Defaults to 'open'",
+				Default:     stringdefault.StaticString("open"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "8021x-radius", "mac-radius", "hybris-radius"),
+				},
+			},
+		},
+	}
+}
+
+func (a *appliancePortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance port resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance port resource")
+}
+
+func (a *appliancePortResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AppliancePortResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an invalid allowed_vlans is reported by its validator
+	var allowed []validators.VlanRange
+	if !config.AllowedVlans.IsUnknown() && !config.AllowedVlans.IsNull() {
+		var err error
+		allowed, err = validators.ParseVlanList(config.AllowedVlans.ValueString())
+		if err != nil {
+			return
+		}
+	}
+
+	if config.Type.IsUnknown() {
+		return
+	}
+	// type defaults to access when not configured
+	if config.Type.ValueString() != "trunk" {
+		if !config.AllowedVlans.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allowed_vlans"),
+				"Invalid appliance port configuration",
+				"allowed_vlans can only be set on trunk ports",
+			)
+		}
+		if !config.DropUntaggedTraffic.IsUnknown() && config.DropUntaggedTraffic.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("drop_untagged_traffic"),
+				"Invalid appliance port configuration",
+				"drop_untagged_traffic can only be enabled on trunk ports",
+			)
+		}
+		return
+	}
+
+	if !config.AccessPolicy.IsUnknown() && !config.AccessPolicy.IsNull() && config.AccessPolicy.ValueString() != "open" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_policy"),
+			"Invalid appliance port configuration",
+			"access_policy can only be set on access ports",
+		)
+	}
+
+	// the native VLAN must be one of the allowed VLANs, unless untagged traffic is dropped
+	dropUntagged := !config.DropUntaggedTraffic.IsUnknown() && config.DropUntaggedTraffic.ValueBool()
+	if allowed != nil && !dropUntagged && !config.Vlan.IsUnknown() && !config.Vlan.IsNull() {
+		vlan := config.Vlan.ValueInt64()
+		if !validators.VlanListContains(allowed, vlan) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("vlan"),
+				"Invalid appliance port configuration",
+				fmt.Sprintf("native VLAN %d is not one of the allowed VLANs %s", vlan, config.AllowedVlans.ValueString()),
+			)
+		}
+	}
+}
+
+func (a *appliancePortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance port resource")
+	var plan AppliancePortResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.validateVlans(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portID := strconv.FormatInt(plan.PortNumber.ValueInt64(), 10)
+	port, err := a.client.UpdateAppliancePort(plan.NetworkID.ValueString(), portID, portToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance port", "Failed to update appliance port: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + portID)
+	portFromAPI(port, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance port resource")
+}
+
+func (a *appliancePortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance port resource")
+	var state AppliancePortResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := a.client.GetAppliancePort(state.NetworkID.ValueString(), strconv.FormatInt(state.PortNumber.ValueInt64(), 10))
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance port", "Failed to get appliance port: "+err.Error())
+		return
+	}
+
+	portFromAPI(port, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance port resource")
+}
+
+func (a *appliancePortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance port resource")
+	var plan AppliancePortResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.validateVlans(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := a.client.UpdateAppliancePort(plan.NetworkID.ValueString(), strconv.FormatInt(plan.PortNumber.ValueInt64(), 10), portToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance port", "Failed to update appliance port: "+err.Error())
+		return
+	}
+
+	portFromAPI(port, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance port resource")
+}
+
+func (a *appliancePortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance port resource")
+	// appliance ports can not be deleted and there is no configuration that is safe to reset them
+	// to, as the default native VLAN may not exist, so the port is only removed from the state
+	tflog.Info(ctx, "Deleted the appliance port resource")
+}
+
+func (a *appliancePortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, portNumber, err := helpers.SplitImportID(req.ID, "network_id/port_number")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	number, err := strconv.ParseInt(portNumber, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("port_number must be a number, got: %s", portNumber))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port_number"), number)...)
+}
+
+// validateVlans checks that the VLANs referenced by the port exist in the network. VLAN ranges of
+// allowed_vlans are not checked, as they usually span IDs that are not in use.
+func (a *appliancePortResource) validateVlans(plan *AppliancePortResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var referenced []int64
+	if !plan.Vlan.IsUnknown() && !plan.Vlan.IsNull() {
+		referenced = append(referenced, plan.Vlan.ValueInt64())
+	}
+	if plan.Type.ValueString() == "trunk" && !plan.AllowedVlans.IsNull() {
+		allowed, err := validators.ParseVlanList(plan.AllowedVlans.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("allowed_vlans"), "Invalid allowed VLANs", err.Error())
+			return diags
+		}
+		for _, r := range allowed {
+			if r.Start == r.End {
+				referenced = append(referenced, r.Start)
+			}
+		}
+	}
+	if len(referenced) == 0 {
+		return diags
+	}
+
+	vlans, err := a.client.GetApplianceVLANs(plan.NetworkID.ValueString())
+	if err != nil {
+		diags.AddError("Failed to get appliance VLANs", "Failed to get appliance VLANs: "+err.Error())
+		return diags
+	}
+	existing := make(map[string]bool, len(vlans))
+	for _, vlan := range vlans {
+		existing[string(vlan.ID)] = true
+	}
+
+	for _, id := range referenced {
+		if !existing[strconv.FormatInt(id, 10)] {
+			diags.AddError(
+				"Invalid appliance port configuration",
+				fmt.Sprintf("VLAN %d does not exist in network %s", id, plan.NetworkID.ValueString()),
+			)
+		}
+	}
+	return diags
+}
+
+func portToAPI(plan *AppliancePortResourceModel) *meraki.AppliancePort {
+	port := &meraki.AppliancePort{
+		Enabled: plan.Enabled.ValueBool(),
+		Type:    plan.Type.ValueString(),
+	}
+	if !plan.Vlan.IsUnknown() && !plan.Vlan.IsNull() {
+		port.Vlan = plan.Vlan.ValueInt64()
+	}
+	if port.Type == "trunk" {
+		port.DropUntaggedTraffic = plan.DropUntaggedTraffic.ValueBool()
+		port.AllowedVlans = "all"
+		if !plan.AllowedVlans.IsNull() {
+			port.AllowedVlans = plan.AllowedVlans.ValueString()
+		}
+	} else {
+		port.AccessPolicy = plan.AccessPolicy.ValueString()
+	}
+	return port
+}
+
+func portFromAPI(port *meraki.AppliancePort, state *AppliancePortResourceModel) {
+	// allowed_vlans is only tracked when configured, or on import where type is not known yet
+	trackAllowed := !state.AllowedVlans.IsNull() || state.Type.IsNull()
+
+	state.Enabled = types.BoolValue(port.Enabled)
+	state.Type = types.StringValue(port.Type)
+	state.DropUntaggedTraffic = types.BoolValue(port.DropUntaggedTraffic)
+	state.Vlan = types.Int64Null()
+	if port.Vlan != 0 {
+		state.Vlan = types.Int64Value(port.Vlan)
+	}
+	state.AccessPolicy = types.StringValue("open")
+	if port.Type != "trunk" && port.AccessPolicy != "" {
+		state.AccessPolicy = types.StringValue(port.AccessPolicy)
+	}
+
+	state.AllowedVlans = types.StringNull()
+	if port.Type == "trunk" && trackAllowed {
+		state.AllowedVlans = helpers.StringValueOrNull(port.AllowedVlans)
+	}
+}
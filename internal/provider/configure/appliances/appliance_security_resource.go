@@ -0,0 +1,460 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net"
+)
+
+var (
+	_ resource.Resource                   = &applianceSecurityResource{}
+	_ resource.ResourceWithConfigure      = &applianceSecurityResource{}
+	_ resource.ResourceWithImportState    = &applianceSecurityResource{}
+	_ resource.ResourceWithValidateConfig = &applianceSecurityResource{}
+)
+
+func NewApplianceSecurityResource() resource.Resource {
+	return &applianceSecurityResource{}
+}
+
+type applianceSecurityResource struct {
+	client meraki.Client
+}
+
+type ApplianceSecurityResourceModel struct {
+	ID                  types.String              `tfsdk:"id"`
+	NetworkID           types.String              `tfsdk:"network_id"`
+	IntrusionMode       types.String              `tfsdk:"intrusion_mode"`
+	IdsRulesets         types.String              `tfsdk:"ids_rulesets"`
+	ProtectedNetworks   *ProtectedNetworksModel   `tfsdk:"protected_networks"`
+	MalwareMode         types.String              `tfsdk:"malware_mode"`
+	MalwareAllowedURLs  []MalwareAllowedURLModel  `tfsdk:"malware_allowed_urls"`
+	MalwareAllowedFiles []MalwareAllowedFileModel `tfsdk:"malware_allowed_files"`
+}
+
+type ProtectedNetworksModel struct {
+	UseDefault   types.Bool `tfsdk:"use_default"`
+	IncludedCidr types.Set  `tfsdk:"included_cidr"`
+	ExcludedCidr types.Set  `tfsdk:"excluded_cidr"`
+}
+
+type MalwareAllowedURLModel struct {
+	URL     types.String `tfsdk:"url"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+type MalwareAllowedFileModel struct {
+	Sha256  types.String `tfsdk:"sha256"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (a *applianceSecurityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_security"
+}
+
+func (a *applianceSecurityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the intrusion detection and prevention and the malware protection settings of an MX appliance network. Both are disabled on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"intrusion_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The intrusion mode, can be 'prevention', 'detection' or 'disabled'. Defaults to 'disabled'",
+				Default:     stringdefault.StaticString("disabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("prevention", "detection", "disabled"),
+				},
+			},
+			"ids_rulesets": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The intrusion detection ruleset, can be 'connectivity', 'balanced' or 'security'. Defaults to 'balanced'",
+				Default:     stringdefault.StaticString("balanced"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("connectivity", "balanced", "security"),
+				},
+			},
+			"protected_networks": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The networks inspected by intrusion detection, all local networks are protected when not set",
+				Attributes: map[string]schema.Attribute{
+					"use_default": schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether to protect the default local networks, included_cidr is required when false",
+					},
+					"included_cidr": schema.SetAttribute{
+						Optional:    true,
+						Description: "The CIDRs of the protected networks when not using the defaults",
+						ElementType: types.StringType,
+					},
+					"excluded_cidr": schema.SetAttribute{
+						Optional:    true,
+						Description: "The CIDRs excluded from protection when not using the defaults",
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"malware_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The malware protection mode, can be 'enabled' or 'disabled'. Defaults to 'disabled'",
+				Default:     stringdefault.StaticString("disabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("enabled", "disabled"),
+				},
+			},
+			"malware_allowed_urls": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The URLs that are never scanned by malware protection",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Required:    true,
+							Description: "The allowed URL or domain",
+						},
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Description: "A comment about the allowed URL",
+						},
+					},
+				},
+			},
+			"malware_allowed_files": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The files that are never blocked by malware protection",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sha256": schema.StringAttribute{
+							Required:    true,
+							Description: "The SHA-256 hash of the allowed file",
+						},
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Description: "A comment about the allowed file",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceSecurityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance security resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance security resource")
+}
+
+func (a *applianceSecurityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceSecurityResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protected := config.ProtectedNetworks
+	if protected == nil || protected.UseDefault.IsUnknown() || protected.IncludedCidr.IsUnknown() || protected.ExcludedCidr.IsUnknown() {
+		return
+	}
+	if !protected.UseDefault.ValueBool() && len(protected.IncludedCidr.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("protected_networks").AtName("included_cidr"),
+			"Invalid protected networks",
+			"included_cidr is required when use_default is false",
+		)
+	}
+	if protected.UseDefault.ValueBool() && (len(protected.IncludedCidr.Elements()) > 0 || len(protected.ExcludedCidr.Elements()) > 0) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("protected_networks"),
+			"Invalid protected networks",
+			"included_cidr and excluded_cidr can only be set when use_default is false",
+		)
+	}
+
+	for _, attr := range []struct {
+		name  string
+		cidrs types.Set
+	}{
+		{"included_cidr", protected.IncludedCidr},
+		{"excluded_cidr", protected.ExcludedCidr},
+	} {
+		for _, element := range attr.cidrs.Elements() {
+			cidr, ok := element.(types.String)
+			if !ok || cidr.IsUnknown() || cidr.IsNull() {
+				continue
+			}
+			if _, _, err := net.ParseCIDR(cidr.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("protected_networks").AtName(attr.name).AtSetValue(cidr),
+					"Invalid protected networks",
+					fmt.Sprintf("%q is not a valid CIDR", cidr.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (a *applianceSecurityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance security resource")
+	var plan ApplianceSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.NetworkID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance security resource")
+}
+
+func (a *applianceSecurityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance security resource")
+	var state ApplianceSecurityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	intrusion, err := a.client.GetApplianceIntrusion(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance intrusion settings", "Failed to get appliance intrusion settings: "+err.Error())
+		return
+	}
+
+	malware, err := a.client.GetApplianceMalware(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance malware settings", "Failed to get appliance malware settings: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(intrusionFromAPI(ctx, intrusion, &state)...)
+	malwareFromAPI(malware, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance security resource")
+}
+
+func (a *applianceSecurityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance security resource")
+	var plan ApplianceSecurityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance security resource")
+}
+
+func (a *applianceSecurityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance security resource")
+	var state ApplianceSecurityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceIntrusion(state.NetworkID.ValueString(), &meraki.ApplianceIntrusion{
+		Mode: "disabled",
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance intrusion settings", "Failed to delete appliance intrusion settings: "+err.Error())
+		return
+	}
+
+	_, err = a.client.UpdateApplianceMalware(state.NetworkID.ValueString(), &meraki.ApplianceMalware{
+		Mode:         "disabled",
+		AllowedURLs:  []meraki.ApplianceMalwareAllowedURL{},
+		AllowedFiles: []meraki.ApplianceMalwareAllowedFile{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance malware settings", "Failed to delete appliance malware settings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance security resource")
+}
+
+func (a *applianceSecurityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+// update applies the intrusion and the malware settings, which are separate endpoints in the API.
+func (a *applianceSecurityResource) update(ctx context.Context, plan *ApplianceSecurityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	intrusion := &meraki.ApplianceIntrusion{
+		Mode:        plan.IntrusionMode.ValueString(),
+		IdsRulesets: plan.IdsRulesets.ValueString(),
+		ProtectedNetworks: &meraki.ApplianceIntrusionProtectedNetworks{
+			UseDefault: true,
+		},
+	}
+	if plan.ProtectedNetworks != nil {
+		intrusion.ProtectedNetworks = &meraki.ApplianceIntrusionProtectedNetworks{
+			UseDefault: plan.ProtectedNetworks.UseDefault.ValueBool(),
+		}
+		diags.Append(helpers.SetToStrings(ctx, plan.ProtectedNetworks.IncludedCidr, &intrusion.ProtectedNetworks.IncludedCidr)...)
+		diags.Append(helpers.SetToStrings(ctx, plan.ProtectedNetworks.ExcludedCidr, &intrusion.ProtectedNetworks.ExcludedCidr)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	updatedIntrusion, err := a.client.UpdateApplianceIntrusion(plan.NetworkID.ValueString(), intrusion)
+	if err != nil {
+		diags.AddError("Failed to update appliance intrusion settings", "Failed to update appliance intrusion settings: "+err.Error())
+		return diags
+	}
+
+	malware := &meraki.ApplianceMalware{
+		Mode:         plan.MalwareMode.ValueString(),
+		AllowedURLs:  make([]meraki.ApplianceMalwareAllowedURL, 0, len(plan.MalwareAllowedURLs)),
+		AllowedFiles: make([]meraki.ApplianceMalwareAllowedFile, 0, len(plan.MalwareAllowedFiles)),
+	}
+	for _, allowed := range plan.MalwareAllowedURLs {
+		malware.AllowedURLs = append(malware.AllowedURLs, meraki.ApplianceMalwareAllowedURL{
+			URL:     allowed.URL.ValueString(),
+			Comment: allowed.Comment.ValueString(),
+		})
+	}
+	for _, allowed := range plan.MalwareAllowedFiles {
+		malware.AllowedFiles = append(malware.AllowedFiles, meraki.ApplianceMalwareAllowedFile{
+			Sha256:  allowed.Sha256.ValueString(),
+			Comment: allowed.Comment.ValueString(),
+		})
+	}
+	updatedMalware, err := a.client.UpdateApplianceMalware(plan.NetworkID.ValueString(), malware)
+	if err != nil {
+		diags.AddError("Failed to update appliance malware settings", "Failed to update appliance malware settings: "+err.Error())
+		return diags
+	}
+
+	diags.Append(intrusionFromAPI(ctx, updatedIntrusion, plan)...)
+	malwareFromAPI(updatedMalware, plan)
+	return diags
+}
+
+func intrusionFromAPI(ctx context.Context, intrusion *meraki.ApplianceIntrusion, state *ApplianceSecurityResourceModel) diag.Diagnostics {
+	state.IntrusionMode = types.StringValue(intrusion.Mode)
+	if intrusion.IdsRulesets != "" {
+		state.IdsRulesets = types.StringValue(intrusion.IdsRulesets)
+	} else if state.IdsRulesets.IsNull() || state.IdsRulesets.IsUnknown() {
+		state.IdsRulesets = types.StringValue("balanced")
+	}
+
+	// the protected networks are only tracked when configured, or when they differ from the
+	// default on import
+	protected := intrusion.ProtectedNetworks
+	if protected == nil || (state.ProtectedNetworks == nil && protected.UseDefault) {
+		state.ProtectedNetworks = nil
+		return nil
+	}
+	prior := ProtectedNetworksModel{
+		IncludedCidr: types.SetNull(types.StringType),
+		ExcludedCidr: types.SetNull(types.StringType),
+	}
+	if state.ProtectedNetworks != nil {
+		prior = *state.ProtectedNetworks
+	}
+	state.ProtectedNetworks = &ProtectedNetworksModel{
+		UseDefault:   types.BoolValue(protected.UseDefault),
+		IncludedCidr: types.SetNull(types.StringType),
+		ExcludedCidr: types.SetNull(types.StringType),
+	}
+	if protected.UseDefault {
+		return nil
+	}
+
+	var diags, d diag.Diagnostics
+	state.ProtectedNetworks.IncludedCidr, d = helpers.SetFromStrings(ctx, prior.IncludedCidr, protected.IncludedCidr)
+	diags.Append(d...)
+	state.ProtectedNetworks.ExcludedCidr, d = helpers.SetFromStrings(ctx, prior.ExcludedCidr, protected.ExcludedCidr)
+	diags.Append(d...)
+	return diags
+}
+
+func malwareFromAPI(malware *meraki.ApplianceMalware, state *ApplianceSecurityResourceModel) {
+	state.MalwareMode = types.StringValue(malware.Mode)
+
+	state.MalwareAllowedURLs = nil
+	for _, allowed := range malware.AllowedURLs {
+		state.MalwareAllowedURLs = append(state.MalwareAllowedURLs, MalwareAllowedURLModel{
+			URL:     types.StringValue(allowed.URL),
+			Comment: helpers.StringValueOrNull(allowed.Comment),
+		})
+	}
+	state.MalwareAllowedFiles = nil
+	for _, allowed := range malware.AllowedFiles {
+		state.MalwareAllowedFiles = append(state.MalwareAllowedFiles, MalwareAllowedFileModel{
+			Sha256:  types.StringValue(allowed.Sha256),
+			Comment: helpers.StringValueOrNull(allowed.Comment),
+		})
+	}
+}
@@ -0,0 +1,411 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"slices"
+)
+
+var (
+	_ resource.Resource                   = &applianceSiteToSiteVPNResource{}
+	_ resource.ResourceWithConfigure      = &applianceSiteToSiteVPNResource{}
+	_ resource.ResourceWithImportState    = &applianceSiteToSiteVPNResource{}
+	_ resource.ResourceWithValidateConfig = &applianceSiteToSiteVPNResource{}
+	_ resource.ResourceWithModifyPlan     = &applianceSiteToSiteVPNResource{}
+)
+
+func NewApplianceSiteToSiteVPNResource() resource.Resource {
+	return &applianceSiteToSiteVPNResource{}
+}
+
+type applianceSiteToSiteVPNResource struct {
+	client meraki.Client
+}
+
+type ApplianceSiteToSiteVPNResourceModel struct {
+	ID        types.String                        `tfsdk:"id"`
+	NetworkID types.String                        `tfsdk:"network_id"`
+	Mode      types.String                        `tfsdk:"mode"`
+	Hubs      []ApplianceSiteToSiteVPNHubModel    `tfsdk:"hubs"`
+	Subnets   []ApplianceSiteToSiteVPNSubnetModel `tfsdk:"subnets"`
+}
+
+type ApplianceSiteToSiteVPNHubModel struct {
+	HubID           types.String `tfsdk:"hub_id"`
+	UseDefaultRoute types.Bool   `tfsdk:"use_default_route"`
+}
+
+type ApplianceSiteToSiteVPNSubnetModel struct {
+	LocalSubnet types.String `tfsdk:"local_subnet"`
+	UseVPN      types.Bool   `tfsdk:"use_vpn"`
+}
+
+func (a *applianceSiteToSiteVPNResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_site_to_site_vpn"
+}
+
+func (a *applianceSiteToSiteVPNResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Auto VPN (site-to-site VPN) settings of an MX appliance network. The network leaves the VPN (mode 'none') on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Required:    true,
+				Description: "The site-to-site VPN mode, can be 'none', 'spoke' or 'hub'",
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "spoke", "hub"),
+				},
+			},
+			"hubs": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The hubs this spoke connects to, in order of priority. Only valid in 'spoke' mode",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hub_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The network ID of the hub. The hub must be a network in 'hub' mode of the same organization",
+						},
+						"use_default_route": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the default route 0.0.0.0/0 is sent through this hub. Defaults to false",
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"subnets": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The local subnets and whether they participate in the VPN. Subnets not listed keep their current setting",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"local_subnet": schema.StringAttribute{
+							Required:    true,
+							Description: "The CIDR notation of a local subnet of the network",
+							Validators: []validator.String{
+								validators.IPv4CIDR(),
+							},
+						},
+						"use_vpn": schema.BoolAttribute{
+							Required:    true,
+							Description: "Whether the subnet is advertised to the VPN",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceSiteToSiteVPNResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance site-to-site VPN resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance site-to-site VPN resource")
+}
+
+func (a *applianceSiteToSiteVPNResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceSiteToSiteVPNResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Mode.IsUnknown() || config.Mode.IsNull() {
+		return
+	}
+	mode := config.Mode.ValueString()
+	if mode != "spoke" && len(config.Hubs) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hubs"),
+			"Invalid site-to-site VPN configuration",
+			fmt.Sprintf("hubs can only be set in 'spoke' mode, got mode %q", mode),
+		)
+	}
+	if mode == "none" && len(config.Subnets) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnets"),
+			"Invalid site-to-site VPN configuration",
+			"subnets can not be set in 'none' mode",
+		)
+	}
+
+	seen := make(map[string]bool)
+	for i, hub := range config.Hubs {
+		if hub.HubID.IsUnknown() {
+			continue
+		}
+		if seen[hub.HubID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hubs").AtListIndex(i).AtName("hub_id"),
+				"Invalid site-to-site VPN configuration",
+				fmt.Sprintf("hub %s is listed more than once", hub.HubID.ValueString()),
+			)
+		}
+		seen[hub.HubID.ValueString()] = true
+	}
+}
+
+func (a *applianceSiteToSiteVPNResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance site-to-site VPN resource")
+	var plan ApplianceSiteToSiteVPNResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpn, err := a.client.UpdateApplianceSiteToSiteVPN(plan.NetworkID.ValueString(), siteToSiteVPNToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance site-to-site VPN", "Failed to update appliance site-to-site VPN: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	siteToSiteVPNFromAPI(vpn, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance site-to-site VPN resource")
+}
+
+func (a *applianceSiteToSiteVPNResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance site-to-site VPN resource")
+	var state ApplianceSiteToSiteVPNResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpn, err := a.client.GetApplianceSiteToSiteVPN(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance site-to-site VPN", "Failed to get appliance site-to-site VPN: "+err.Error())
+		return
+	}
+
+	siteToSiteVPNFromAPI(vpn, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance site-to-site VPN resource")
+}
+
+func (a *applianceSiteToSiteVPNResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance site-to-site VPN resource")
+	var plan ApplianceSiteToSiteVPNResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpn, err := a.client.UpdateApplianceSiteToSiteVPN(plan.NetworkID.ValueString(), siteToSiteVPNToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance site-to-site VPN", "Failed to update appliance site-to-site VPN: "+err.Error())
+		return
+	}
+
+	siteToSiteVPNFromAPI(vpn, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance site-to-site VPN resource")
+}
+
+func (a *applianceSiteToSiteVPNResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance site-to-site VPN resource")
+	var state ApplianceSiteToSiteVPNResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceSiteToSiteVPN(state.NetworkID.ValueString(), &meraki.ApplianceSiteToSiteVPN{
+		Mode: "none",
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance site-to-site VPN", "Failed to delete appliance site-to-site VPN: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance site-to-site VPN resource")
+}
+
+func (a *applianceSiteToSiteVPNResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+// ModifyPlan checks that every referenced hub is a network in 'hub' mode of the same organization as
+// the spoke whenever the hubs change, as the API accepts other networks as hubs but the tunnels never
+// come up.
+func (a *applianceSiteToSiteVPNResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || a.client == nil {
+		return
+	}
+
+	var plan ApplianceSiteToSiteVPNResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.NetworkID.IsUnknown() || len(plan.Hubs) == 0 {
+		return
+	}
+	hubIDs := make([]string, 0, len(plan.Hubs))
+	for _, hub := range plan.Hubs {
+		if hub.HubID.IsUnknown() {
+			return
+		}
+		hubIDs = append(hubIDs, hub.HubID.ValueString())
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state ApplianceSiteToSiteVPNResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		stateHubIDs := make([]string, 0, len(state.Hubs))
+		for _, hub := range state.Hubs {
+			stateHubIDs = append(stateHubIDs, hub.HubID.ValueString())
+		}
+		if state.NetworkID.Equal(plan.NetworkID) && slices.Equal(hubIDs, stateHubIDs) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(a.validateHubs(plan.NetworkID.ValueString(), hubIDs)...)
+}
+
+// validateHubs looks up the VPN mode of all hubs at once through the VPN statuses of the organization,
+// which only lists the networks of that organization.
+func (a *applianceSiteToSiteVPNResource) validateHubs(networkID string, hubIDs []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	network, err := a.client.GetNetwork(networkID)
+	if err != nil {
+		diags.AddError("Failed to get network", "Failed to get network: "+err.Error())
+		return diags
+	}
+	statuses, err := a.client.GetOrganizationApplianceVPNStatuses(network.OrgID, hubIDs)
+	if err != nil {
+		diags.AddError("Failed to get appliance VPN statuses", "Failed to get appliance VPN statuses: "+err.Error())
+		return diags
+	}
+
+	modes := make(map[string]string, len(statuses))
+	for _, status := range statuses {
+		modes[status.NetworkID] = status.VpnMode
+	}
+	for i, hubID := range hubIDs {
+		hubPath := path.Root("hubs").AtListIndex(i).AtName("hub_id")
+		mode, ok := modes[hubID]
+		switch {
+		case hubID == networkID:
+			diags.AddAttributeError(hubPath, "Invalid hub", "a network can not be a hub of itself")
+		case !ok:
+			diags.AddAttributeError(hubPath, "Invalid hub", fmt.Sprintf("hub network %s is not an appliance network of organization %s", hubID, network.OrgID))
+		case mode != "hub":
+			diags.AddAttributeError(hubPath, "Invalid hub", fmt.Sprintf("network %s is in %q mode, expected 'hub'", hubID, mode))
+		}
+	}
+	return diags
+}
+
+func siteToSiteVPNToAPI(plan *ApplianceSiteToSiteVPNResourceModel) *meraki.ApplianceSiteToSiteVPN {
+	vpn := &meraki.ApplianceSiteToSiteVPN{
+		Mode: plan.Mode.ValueString(),
+	}
+	for _, hub := range plan.Hubs {
+		vpn.Hubs = append(vpn.Hubs, meraki.ApplianceSiteToSiteVPNHub{
+			HubID:           hub.HubID.ValueString(),
+			UseDefaultRoute: hub.UseDefaultRoute.ValueBool(),
+		})
+	}
+	for _, subnet := range plan.Subnets {
+		vpn.Subnets = append(vpn.Subnets, meraki.ApplianceSiteToSiteVPNSubnet{
+			LocalSubnet: subnet.LocalSubnet.ValueString(),
+			UseVPN:      subnet.UseVPN.ValueBool(),
+		})
+	}
+	return vpn
+}
+
+func siteToSiteVPNFromAPI(vpn *meraki.ApplianceSiteToSiteVPN, state *ApplianceSiteToSiteVPNResourceModel) {
+	state.Mode = types.StringValue(vpn.Mode)
+
+	state.Hubs = nil
+	for _, hub := range vpn.Hubs {
+		state.Hubs = append(state.Hubs, ApplianceSiteToSiteVPNHubModel{
+			HubID:           types.StringValue(hub.HubID),
+			UseDefaultRoute: types.BoolValue(hub.UseDefaultRoute),
+		})
+	}
+
+	// only track the subnets listed in the configuration so that new VLANs do not show up as drift
+	if state.Subnets == nil {
+		return
+	}
+	useVPN := make(map[string]bool)
+	for _, subnet := range vpn.Subnets {
+		useVPN[subnet.LocalSubnet] = subnet.UseVPN
+	}
+	subnets := make([]ApplianceSiteToSiteVPNSubnetModel, 0, len(state.Subnets))
+	for _, subnet := range state.Subnets {
+		enabled, ok := useVPN[subnet.LocalSubnet.ValueString()]
+		if !ok {
+			continue
+		}
+		subnets = append(subnets, ApplianceSiteToSiteVPNSubnetModel{
+			LocalSubnet: subnet.LocalSubnet,
+			UseVPN:      types.BoolValue(enabled),
+		})
+	}
+	state.Subnets = subnets
+}
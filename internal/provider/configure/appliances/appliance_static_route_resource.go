@@ -0,0 +1,383 @@
+package appliances
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net"
+)
+
+var (
+	_ resource.Resource                     = &applianceStaticRouteResource{}
+	_ resource.ResourceWithConfigure        = &applianceStaticRouteResource{}
+	_ resource.ResourceWithImportState      = &applianceStaticRouteResource{}
+	_ resource.ResourceWithValidateConfig   = &applianceStaticRouteResource{}
+	_ resource.ResourceWithConfigValidators = &applianceStaticRouteResource{}
+)
+
+func NewApplianceStaticRouteResource() resource.Resource {
+	return &applianceStaticRouteResource{}
+}
+
+type applianceStaticRouteResource struct {
+	client meraki.Client
+}
+
+type ApplianceStaticRouteResourceModel struct {
+	ID                 types.String                      `tfsdk:"id"`
+	NetworkID          types.String                      `tfsdk:"network_id"`
+	RouteID            types.String                      `tfsdk:"route_id"`
+	Name               types.String                      `tfsdk:"name"`
+	Subnet             types.String                      `tfsdk:"subnet"`
+	GatewayIP          types.String                      `tfsdk:"gateway_ip"`
+	GatewayVlanID      types.Int64                       `tfsdk:"gateway_vlan_id"`
+	Enabled            types.Bool                        `tfsdk:"enabled"`
+	FixedIPAssignments map[string]FixedIPAssignmentModel `tfsdk:"fixed_ip_assignments"`
+	ReservedIPRanges   []ReservedIPRangeModel            `tfsdk:"reserved_ip_ranges"`
+}
+
+func (a *applianceStaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_static_route"
+}
+
+func (a *applianceStaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a static route of an MX appliance network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/route_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network the static route belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"route_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the static route",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the static route",
+			},
+			"subnet": schema.StringAttribute{
+				Required:    true,
+				Description: "The destination subnet of the static route in CIDR notation, e.g. '10.10.0.0/16'",
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"gateway_ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The next hop IP of the static route. At least one of gateway_ip and gateway_vlan_id must be set",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"gateway_vlan_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the VLAN the next hop is reached through. At least one of gateway_ip and gateway_vlan_id must be set",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the static route is enabled. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"fixed_ip_assignments": fixedIPAssignmentsAttribute("DHCP fixed IP assignments on the static route subnet keyed by client MAC address"),
+			"reserved_ip_ranges":   reservedIPRangesAttribute("IP ranges of the static route subnet the DHCP server will not hand out"),
+		},
+	}
+}
+
+func (a *applianceStaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance static route resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance static route resource")
+}
+
+func (a *applianceStaticRouteResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("gateway_ip"),
+			path.MatchRoot("gateway_vlan_id"),
+		),
+	}
+}
+
+func (a *applianceStaticRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Subnet.IsUnknown() || config.Subnet.IsNull() {
+		return
+	}
+	ip, subnet, err := net.ParseCIDR(config.Subnet.ValueString())
+	if err != nil {
+		// reported by the attribute validators
+		return
+	}
+	if !ip.Equal(subnet.IP) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnet"),
+			"Invalid static route subnet",
+			fmt.Sprintf("subnet %s has host bits set, did you mean %s?", config.Subnet.ValueString(), subnet),
+		)
+		return
+	}
+
+	if !config.GatewayIP.IsUnknown() && !config.GatewayIP.IsNull() {
+		if gateway := net.ParseIP(config.GatewayIP.ValueString()); gateway != nil && subnet.Contains(gateway) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("gateway_ip"),
+				"Invalid static route gateway",
+				fmt.Sprintf("gateway_ip %s must not be within the destination subnet %s", gateway, subnet),
+			)
+		}
+	}
+
+	for mac, assignment := range config.FixedIPAssignments {
+		if assignment.IP.IsUnknown() {
+			continue
+		}
+		if ip := net.ParseIP(assignment.IP.ValueString()); ip != nil && !subnet.Contains(ip) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fixed_ip_assignments").AtMapKey(mac).AtName("ip"),
+				"Invalid fixed IP assignment",
+				fmt.Sprintf("ip %s is not within subnet %s", ip, subnet),
+			)
+		}
+	}
+
+	for i, r := range config.ReservedIPRanges {
+		if r.Start.IsUnknown() || r.End.IsUnknown() {
+			continue
+		}
+		start, end := net.ParseIP(r.Start.ValueString()), net.ParseIP(r.End.ValueString())
+		if start == nil || end == nil {
+			continue
+		}
+		rangePath := path.Root("reserved_ip_ranges").AtListIndex(i)
+		if !subnet.Contains(start) || !subnet.Contains(end) {
+			resp.Diagnostics.AddAttributeError(
+				rangePath,
+				"Invalid reserved IP range",
+				fmt.Sprintf("range %s - %s is not within subnet %s", start, end, subnet),
+			)
+			continue
+		}
+		if bytes.Compare(start.To4(), end.To4()) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				rangePath,
+				"Invalid reserved IP range",
+				fmt.Sprintf("start %s is after end %s", start, end),
+			)
+		}
+	}
+}
+
+func (a *applianceStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance static route resource")
+	var plan ApplianceStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := plan.NetworkID.ValueString()
+	routeReqData := staticRouteToAPI(&plan)
+
+	// enabled, fixed IP assignments and reserved ranges can only be set by updating the route after it has been created
+	created, err := a.client.CreateApplianceStaticRoute(networkID, &meraki.ApplianceStaticRouteCreateRequest{
+		Name:          routeReqData.Name,
+		Subnet:        routeReqData.Subnet,
+		GatewayIP:     routeReqData.GatewayIP,
+		GatewayVlanID: routeReqData.GatewayVlanID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create appliance static route", "Failed to create appliance static route: "+err.Error())
+		return
+	}
+	plan.ID = types.StringValue(networkID + "/" + created.ID)
+	plan.RouteID = types.StringValue(created.ID)
+
+	// save the created route first, so it is tainted instead of orphaned when the update fails
+	createdState := plan
+	staticRouteFromAPI(created, &createdState)
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := a.client.UpdateApplianceStaticRoute(networkID, created.ID, routeReqData)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance static route", "Failed to update appliance static route: "+err.Error())
+		return
+	}
+
+	staticRouteFromAPI(route, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance static route resource")
+}
+
+func (a *applianceStaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance static route resource")
+	var state ApplianceStaticRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := a.client.GetApplianceStaticRoute(state.NetworkID.ValueString(), state.RouteID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance static route", "Failed to get appliance static route: "+err.Error())
+		return
+	}
+
+	staticRouteFromAPI(route, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance static route resource")
+}
+
+func (a *applianceStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance static route resource")
+	var plan ApplianceStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := a.client.UpdateApplianceStaticRoute(plan.NetworkID.ValueString(), plan.RouteID.ValueString(), staticRouteToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance static route", "Failed to update appliance static route: "+err.Error())
+		return
+	}
+
+	staticRouteFromAPI(route, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance static route resource")
+}
+
+func (a *applianceStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance static route resource")
+	var state ApplianceStaticRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.DeleteApplianceStaticRoute(state.NetworkID.ValueString(), state.RouteID.ValueString())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance static route", "Failed to delete appliance static route: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance static route resource")
+}
+
+func (a *applianceStaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, routeID, err := helpers.SplitImportID(req.ID, "network_id/route_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("route_id"), routeID)...)
+}
+
+func staticRouteToAPI(plan *ApplianceStaticRouteResourceModel) *meraki.ApplianceStaticRoute {
+	enabled := plan.Enabled.ValueBool()
+	route := &meraki.ApplianceStaticRoute{
+		Name:               plan.Name.ValueString(),
+		Subnet:             plan.Subnet.ValueString(),
+		GatewayIP:          plan.GatewayIP.ValueString(),
+		Enabled:            &enabled,
+		FixedIPAssignments: fixedIPAssignmentsToAPI(plan.FixedIPAssignments),
+		ReservedIPRanges:   reservedIPRangesToAPI(plan.ReservedIPRanges),
+	}
+	if !plan.GatewayVlanID.IsNull() && !plan.GatewayVlanID.IsUnknown() {
+		vlanID := plan.GatewayVlanID.ValueInt64()
+		route.GatewayVlanID = &vlanID
+	}
+	return route
+}
+
+func staticRouteFromAPI(route *meraki.ApplianceStaticRoute, state *ApplianceStaticRouteResourceModel) {
+	state.Name = types.StringValue(route.Name)
+	state.Subnet = types.StringValue(route.Subnet)
+	state.Enabled = types.BoolValue(route.Enabled == nil || *route.Enabled)
+	state.FixedIPAssignments = fixedIPAssignmentsFromAPI(state.FixedIPAssignments, route.FixedIPAssignments)
+	state.ReservedIPRanges = reservedIPRangesFromAPI(route.ReservedIPRanges)
+
+	// the API fills in the gateway attribute that was not configured, only track the configured ones
+	// unless neither is known, e.g. after an import
+	track := state.GatewayIP.IsNull() && state.GatewayVlanID.IsNull()
+	if track || !state.GatewayIP.IsNull() {
+		state.GatewayIP = helpers.StringValueOrNull(route.GatewayIP)
+	}
+	if track || !state.GatewayVlanID.IsNull() {
+		state.GatewayVlanID = types.Int64Null()
+		if route.GatewayVlanID != nil {
+			state.GatewayVlanID = types.Int64Value(*route.GatewayVlanID)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &applianceTrafficShapingRulesResource{}
+	_ resource.ResourceWithConfigure      = &applianceTrafficShapingRulesResource{}
+	_ resource.ResourceWithImportState    = &applianceTrafficShapingRulesResource{}
+	_ resource.ResourceWithValidateConfig = &applianceTrafficShapingRulesResource{}
+)
+
+// dscpTagValues are the DSCP values the API accepts for traffic shaping rules.
+var dscpTagValues = []int64{0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56}
+
+func NewApplianceTrafficShapingRulesResource() resource.Resource {
+	return &applianceTrafficShapingRulesResource{}
+}
+
+type applianceTrafficShapingRulesResource struct {
+	client meraki.Client
+}
+
+type ApplianceTrafficShapingRulesResourceModel struct {
+	ID                  types.String                       `tfsdk:"id"`
+	NetworkID           types.String                       `tfsdk:"network_id"`
+	DefaultRulesEnabled types.Bool                         `tfsdk:"default_rules_enabled"`
+	Rules               []ApplianceTrafficShapingRuleModel `tfsdk:"rules"`
+}
+
+type ApplianceTrafficShapingRuleModel struct {
+	Definitions              []ApplianceTrafficShapingDefinitionModel `tfsdk:"definitions"`
+	PerClientBandwidthLimits *PerClientBandwidthLimitsModel           `tfsdk:"per_client_bandwidth_limits"`
+	DscpTagValue             types.Int64                              `tfsdk:"dscp_tag_value"`
+	Priority                 types.String                             `tfsdk:"priority"`
+}
+
+type ApplianceTrafficShapingDefinitionModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type PerClientBandwidthLimitsModel struct {
+	Settings        types.String         `tfsdk:"settings"`
+	BandwidthLimits *BandwidthLimitModel `tfsdk:"bandwidth_limits"`
+}
+
+func (a *applianceTrafficShapingRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_traffic_shaping_rules"
+}
+
+func (a *applianceTrafficShapingRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete, ordered list of traffic shaping rules of an MX appliance network. All rules are removed and the default rules are enabled on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_rules_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the Meraki default traffic shaping rules are applied. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The traffic shaping rules in the order they are evaluated",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"definitions": schema.ListNestedAttribute{
+							Required:    true,
+							Description: "The traffic the rule applies to, traffic matching any of the definitions is shaped",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "The type of the definition, can be 'application', 'applicationCategory', 'host', 'port', 'ipRange' or 'localNet'",
+										Validators: []validator.String{
+											stringvalidator.OneOf("application", "applicationCategory", "host", "port", "ipRange", "localNet"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Required:    true,
+										Description: "The host, port, IP range or local network, or the ID of the application or application category, e.g. 'meraki:layer7/application/67'",
+									},
+								},
+							},
+						},
+						"per_client_bandwidth_limits": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "The per-client bandwidth limits of the matched traffic. The network default applies when not set",
+							Attributes: map[string]schema.Attribute{
+								"settings": schema.StringAttribute{
+									Required:    true,
+									Description: "How the limits are applied, can be 'network default', 'ignore' or 'custom'",
+									Validators: []validator.String{
+										stringvalidator.OneOf("network default", "ignore", "custom"),
+									},
+								},
+								"bandwidth_limits": schema.SingleNestedAttribute{
+									Optional:    true,
+									Description: "The custom limits, required when settings is 'custom'",
+									Attributes:  bandwidthLimitAttributes(),
+								},
+							},
+						},
+						"dscp_tag_value": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The DSCP tag applied to the matched traffic. The tag is left unchanged when not set",
+							Validators: []validator.Int64{
+								int64validator.OneOf(dscpTagValues...),
+							},
+						},
+						"priority": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The priority of the matched traffic, can be 'low', 'normal' or 'high'. Defaults to 'normal'",
+							Default:     stringdefault.StaticString("normal"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("low", "normal", "high"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceTrafficShapingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance traffic shaping rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance traffic shaping rules resource")
+}
+
+func (a *applianceTrafficShapingRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceTrafficShapingRulesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rules {
+		limits := rule.PerClientBandwidthLimits
+		if limits == nil || limits.Settings.IsUnknown() {
+			continue
+		}
+		custom := limits.Settings.ValueString() == "custom"
+		if custom != (limits.BandwidthLimits != nil) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i).AtName("per_client_bandwidth_limits").AtName("bandwidth_limits"),
+				"Invalid traffic shaping rule",
+				fmt.Sprintf("bandwidth_limits must be set if and only if settings is 'custom', got settings %q", limits.Settings.ValueString()),
+			)
+		}
+	}
+}
+
+func (a *applianceTrafficShapingRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance traffic shaping rules resource")
+	var plan ApplianceTrafficShapingRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceTrafficShapingRules(plan.NetworkID.ValueString(), trafficShapingRulesToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance traffic shaping rules", "Failed to update appliance traffic shaping rules: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	trafficShapingRulesFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance traffic shaping rules resource")
+}
+
+func (a *applianceTrafficShapingRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance traffic shaping rules resource")
+	var state ApplianceTrafficShapingRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.GetApplianceTrafficShapingRules(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance traffic shaping rules", "Failed to get appliance traffic shaping rules: "+err.Error())
+		return
+	}
+
+	trafficShapingRulesFromAPI(rules, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance traffic shaping rules resource")
+}
+
+func (a *applianceTrafficShapingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance traffic shaping rules resource")
+	var plan ApplianceTrafficShapingRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := a.client.UpdateApplianceTrafficShapingRules(plan.NetworkID.ValueString(), trafficShapingRulesToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance traffic shaping rules", "Failed to update appliance traffic shaping rules: "+err.Error())
+		return
+	}
+
+	trafficShapingRulesFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance traffic shaping rules resource")
+}
+
+func (a *applianceTrafficShapingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance traffic shaping rules resource")
+	var state ApplianceTrafficShapingRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceTrafficShapingRules(state.NetworkID.ValueString(), &meraki.ApplianceTrafficShapingRules{
+		DefaultRulesEnabled: true,
+		Rules:               []meraki.ApplianceTrafficShapingRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance traffic shaping rules", "Failed to delete appliance traffic shaping rules: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance traffic shaping rules resource")
+}
+
+func (a *applianceTrafficShapingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func trafficShapingRulesToAPI(plan *ApplianceTrafficShapingRulesResourceModel) *meraki.ApplianceTrafficShapingRules {
+	result := &meraki.ApplianceTrafficShapingRules{
+		DefaultRulesEnabled: plan.DefaultRulesEnabled.ValueBool(),
+		Rules:               make([]meraki.ApplianceTrafficShapingRule, 0, len(plan.Rules)),
+	}
+	for _, rule := range plan.Rules {
+		r := meraki.ApplianceTrafficShapingRule{
+			Definitions: make([]meraki.ApplianceTrafficShapingDefinition, 0, len(rule.Definitions)),
+			Priority:    rule.Priority.ValueString(),
+		}
+		for _, definition := range rule.Definitions {
+			r.Definitions = append(r.Definitions, meraki.ApplianceTrafficShapingDefinition{
+				Type:  definition.Type.ValueString(),
+				Value: definition.Value.ValueString(),
+			})
+		}
+		if limits := rule.PerClientBandwidthLimits; limits != nil {
+			r.PerClientBandwidthLimits = &meraki.ApplianceTrafficShapingPerClientBandwidthLimits{
+				Settings: limits.Settings.ValueString(),
+			}
+			if limits.BandwidthLimits != nil {
+				r.PerClientBandwidthLimits.BandwidthLimits = bandwidthLimitToAPI(limits.BandwidthLimits)
+			}
+		}
+		if !rule.DscpTagValue.IsNull() && !rule.DscpTagValue.IsUnknown() {
+			dscp := rule.DscpTagValue.ValueInt64()
+			r.DscpTagValue = &dscp
+		}
+		result.Rules = append(result.Rules, r)
+	}
+	return result
+}
+
+func trafficShapingRulesFromAPI(rules *meraki.ApplianceTrafficShapingRules, state *ApplianceTrafficShapingRulesResourceModel) {
+	state.DefaultRulesEnabled = types.BoolValue(rules.DefaultRulesEnabled)
+
+	result := make([]ApplianceTrafficShapingRuleModel, 0, len(rules.Rules))
+	for i, rule := range rules.Rules {
+		var previous ApplianceTrafficShapingRuleModel
+		if i < len(state.Rules) {
+			previous = state.Rules[i]
+		}
+
+		model := ApplianceTrafficShapingRuleModel{
+			Definitions:  make([]ApplianceTrafficShapingDefinitionModel, 0, len(rule.Definitions)),
+			DscpTagValue: types.Int64Null(),
+			Priority:     types.StringValue(rule.Priority),
+		}
+		for _, definition := range rule.Definitions {
+			model.Definitions = append(model.Definitions, ApplianceTrafficShapingDefinitionModel{
+				Type:  types.StringValue(definition.Type),
+				Value: types.StringValue(definition.Value),
+			})
+		}
+		if rule.DscpTagValue != nil {
+			model.DscpTagValue = types.Int64Value(*rule.DscpTagValue)
+		}
+		if model.Priority.ValueString() == "" {
+			model.Priority = types.StringValue("normal")
+		}
+
+		// the API reports the network default explicitly, only track it when it was configured
+		limits := rule.PerClientBandwidthLimits
+		if limits != nil && (previous.PerClientBandwidthLimits != nil || limits.Settings != "network default") {
+			model.PerClientBandwidthLimits = &PerClientBandwidthLimitsModel{
+				Settings: types.StringValue(limits.Settings),
+			}
+			if limits.Settings == "custom" {
+				model.PerClientBandwidthLimits.BandwidthLimits = bandwidthLimitFromAPI(limits.BandwidthLimits)
+			}
+		}
+
+		result = append(result, model)
+	}
+	state.Rules = result
+}
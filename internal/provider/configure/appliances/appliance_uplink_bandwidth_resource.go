@@ -0,0 +1,260 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &applianceUplinkBandwidthResource{}
+	_ resource.ResourceWithConfigure   = &applianceUplinkBandwidthResource{}
+	_ resource.ResourceWithImportState = &applianceUplinkBandwidthResource{}
+)
+
+func NewApplianceUplinkBandwidthResource() resource.Resource {
+	return &applianceUplinkBandwidthResource{}
+}
+
+type applianceUplinkBandwidthResource struct {
+	client meraki.Client
+}
+
+type ApplianceUplinkBandwidthResourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	NetworkID types.String         `tfsdk:"network_id"`
+	Wan1      *BandwidthLimitModel `tfsdk:"wan1"`
+	Wan2      *BandwidthLimitModel `tfsdk:"wan2"`
+	Cellular  *BandwidthLimitModel `tfsdk:"cellular"`
+}
+
+type BandwidthLimitModel struct {
+	LimitUp   types.Int64 `tfsdk:"limit_up"`
+	LimitDown types.Int64 `tfsdk:"limit_down"`
+}
+
+// bandwidthLimitAttributes returns the schema of an upload and download limit pair in Kbps.
+func bandwidthLimitAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"limit_up": schema.Int64Attribute{
+			Required:    true,
+			Description: "The upload limit in Kbps",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"limit_down": schema.Int64Attribute{
+			Required:    true,
+			Description: "The download limit in Kbps",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+	}
+}
+
+func (a *applianceUplinkBandwidthResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_uplink_bandwidth"
+}
+
+func (a *applianceUplinkBandwidthResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the bandwidth limits of the uplinks of an MX appliance network. Uplinks that are not configured are unlimited. All limits are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wan1": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The bandwidth limits of the WAN 1 uplink",
+				Attributes:  bandwidthLimitAttributes(),
+			},
+			"wan2": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The bandwidth limits of the WAN 2 uplink",
+				Attributes:  bandwidthLimitAttributes(),
+			},
+			"cellular": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The bandwidth limits of the cellular uplink",
+				Attributes:  bandwidthLimitAttributes(),
+			},
+		},
+	}
+}
+
+func (a *applianceUplinkBandwidthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance uplink bandwidth resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance uplink bandwidth resource")
+}
+
+func (a *applianceUplinkBandwidthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance uplink bandwidth resource")
+	var plan ApplianceUplinkBandwidthResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bandwidth, err := a.client.UpdateApplianceUplinkBandwidth(plan.NetworkID.ValueString(), uplinkBandwidthToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance uplink bandwidth", "Failed to update appliance uplink bandwidth: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	uplinkBandwidthFromAPI(bandwidth, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance uplink bandwidth resource")
+}
+
+func (a *applianceUplinkBandwidthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance uplink bandwidth resource")
+	var state ApplianceUplinkBandwidthResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bandwidth, err := a.client.GetApplianceUplinkBandwidth(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance uplink bandwidth", "Failed to get appliance uplink bandwidth: "+err.Error())
+		return
+	}
+
+	uplinkBandwidthFromAPI(bandwidth, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance uplink bandwidth resource")
+}
+
+func (a *applianceUplinkBandwidthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance uplink bandwidth resource")
+	var plan ApplianceUplinkBandwidthResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bandwidth, err := a.client.UpdateApplianceUplinkBandwidth(plan.NetworkID.ValueString(), uplinkBandwidthToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance uplink bandwidth", "Failed to update appliance uplink bandwidth: "+err.Error())
+		return
+	}
+
+	uplinkBandwidthFromAPI(bandwidth, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance uplink bandwidth resource")
+}
+
+func (a *applianceUplinkBandwidthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance uplink bandwidth resource")
+	var state ApplianceUplinkBandwidthResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceUplinkBandwidth(state.NetworkID.ValueString(), uplinkBandwidthToAPI(&ApplianceUplinkBandwidthResourceModel{}))
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance uplink bandwidth", "Failed to delete appliance uplink bandwidth: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance uplink bandwidth resource")
+}
+
+func (a *applianceUplinkBandwidthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+// bandwidthLimitToAPI converts a limit pair, an unset pair is sent as unlimited.
+func bandwidthLimitToAPI(limit *BandwidthLimitModel) *meraki.ApplianceBandwidthLimit {
+	result := &meraki.ApplianceBandwidthLimit{}
+	if limit != nil {
+		up, down := limit.LimitUp.ValueInt64(), limit.LimitDown.ValueInt64()
+		result.LimitUp = &up
+		result.LimitDown = &down
+	}
+	return result
+}
+
+func bandwidthLimitFromAPI(limit *meraki.ApplianceBandwidthLimit) *BandwidthLimitModel {
+	if limit == nil || (limit.LimitUp == nil && limit.LimitDown == nil) {
+		return nil
+	}
+	result := &BandwidthLimitModel{
+		LimitUp:   types.Int64Value(0),
+		LimitDown: types.Int64Value(0),
+	}
+	if limit.LimitUp != nil {
+		result.LimitUp = types.Int64Value(*limit.LimitUp)
+	}
+	if limit.LimitDown != nil {
+		result.LimitDown = types.Int64Value(*limit.LimitDown)
+	}
+	return result
+}
+
+func uplinkBandwidthToAPI(plan *ApplianceUplinkBandwidthResourceModel) *meraki.ApplianceUplinkBandwidth {
+	bandwidth := &meraki.ApplianceUplinkBandwidth{}
+	bandwidth.BandwidthLimits.Wan1 = bandwidthLimitToAPI(plan.Wan1)
+	bandwidth.BandwidthLimits.Wan2 = bandwidthLimitToAPI(plan.Wan2)
+	bandwidth.BandwidthLimits.Cellular = bandwidthLimitToAPI(plan.Cellular)
+	return bandwidth
+}
+
+func uplinkBandwidthFromAPI(bandwidth *meraki.ApplianceUplinkBandwidth, state *ApplianceUplinkBandwidthResourceModel) {
+	state.Wan1 = bandwidthLimitFromAPI(bandwidth.BandwidthLimits.Wan1)
+	state.Wan2 = bandwidthLimitFromAPI(bandwidth.BandwidthLimits.Wan2)
+	state.Cellular = bandwidthLimitFromAPI(bandwidth.BandwidthLimits.Cellular)
+}
@@ -0,0 +1,643 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &applianceUplinkSelectionResource{}
+	_ resource.ResourceWithConfigure      = &applianceUplinkSelectionResource{}
+	_ resource.ResourceWithImportState    = &applianceUplinkSelectionResource{}
+	_ resource.ResourceWithValidateConfig = &applianceUplinkSelectionResource{}
+)
+
+func NewApplianceUplinkSelectionResource() resource.Resource {
+	return &applianceUplinkSelectionResource{}
+}
+
+type applianceUplinkSelectionResource struct {
+	client meraki.Client
+}
+
+type ApplianceUplinkSelectionResourceModel struct {
+	ID                                  types.String                      `tfsdk:"id"`
+	NetworkID                           types.String                      `tfsdk:"network_id"`
+	DefaultUplink                       types.String                      `tfsdk:"default_uplink"`
+	LoadBalancingEnabled                types.Bool                        `tfsdk:"load_balancing_enabled"`
+	ActiveActiveAutoVpnEnabled          types.Bool                        `tfsdk:"active_active_auto_vpn_enabled"`
+	ImmediateFailoverAndFailbackEnabled types.Bool                        `tfsdk:"immediate_failover_and_failback_enabled"`
+	WanTrafficUplinkPreferences         []WanTrafficUplinkPreferenceModel `tfsdk:"wan_traffic_uplink_preferences"`
+	VpnTrafficUplinkPreferences         []VpnTrafficUplinkPreferenceModel `tfsdk:"vpn_traffic_uplink_preferences"`
+}
+
+type WanTrafficUplinkPreferenceModel struct {
+	PreferredUplink types.String         `tfsdk:"preferred_uplink"`
+	TrafficFilters  []TrafficFilterModel `tfsdk:"traffic_filters"`
+}
+
+type VpnTrafficUplinkPreferenceModel struct {
+	PreferredUplink   types.String           `tfsdk:"preferred_uplink"`
+	FailOverCriterion types.String           `tfsdk:"fail_over_criterion"`
+	PerformanceClass  *PerformanceClassModel `tfsdk:"performance_class"`
+	TrafficFilters    []TrafficFilterModel   `tfsdk:"traffic_filters"`
+}
+
+type PerformanceClassModel struct {
+	Type                        types.String `tfsdk:"type"`
+	BuiltinPerformanceClassName types.String `tfsdk:"builtin_performance_class_name"`
+	CustomPerformanceClassID    types.String `tfsdk:"custom_performance_class_id"`
+}
+
+type TrafficFilterModel struct {
+	Type        types.String                `tfsdk:"type"`
+	ID          types.String                `tfsdk:"id"`
+	Protocol    types.String                `tfsdk:"protocol"`
+	Source      *TrafficFilterEndpointModel `tfsdk:"source"`
+	Destination *TrafficFilterEndpointModel `tfsdk:"destination"`
+}
+
+type TrafficFilterEndpointModel struct {
+	Port    types.String `tfsdk:"port"`
+	Cidr    types.String `tfsdk:"cidr"`
+	Network types.String `tfsdk:"network"`
+	Vlan    types.Int64  `tfsdk:"vlan"`
+	Host    types.Int64  `tfsdk:"host"`
+	Fqdn    types.String `tfsdk:"fqdn"`
+}
+
+func trafficFilterEndpointAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description + ", for custom filters. Unset fields match any",
+		Attributes: map[string]schema.Attribute{
+			"port": schema.StringAttribute{
+				Optional:    true,
+				Description: "The port or port range, e.g. '80' or '1000-2000'",
+			},
+			"cidr": schema.StringAttribute{
+				Optional:    true,
+				Description: "The IP address or subnet in CIDR notation",
+			},
+			"network": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of a network in the organization, VPN filters only",
+			},
+			"vlan": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The VLAN ID of the local network",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"host": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The host ID within the VLAN, requires vlan",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 254),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				Optional:    true,
+				Description: "The fully qualified domain name, VPN destination only",
+			},
+		},
+	}
+}
+
+func trafficFiltersAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Required:    true,
+		Description: "The traffic the preference applies to",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "The type of the filter, can be 'custom', 'application' or 'applicationCategory'. Defaults to 'custom'. WAN filters only support 'custom'",
+					Default:     stringdefault.StaticString("custom"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("custom", "application", "applicationCategory"),
+					},
+				},
+				"id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The ID of the application or application category, e.g. 'meraki:layer7/application/3'",
+				},
+				"protocol": schema.StringAttribute{
+					Optional:    true,
+					Description: "The protocol of custom filters, can be 'tcp', 'udp', 'icmp', 'icmp6' or 'any'",
+					Validators: []validator.String{
+						stringvalidator.OneOf("tcp", "udp", "icmp", "icmp6", "any"),
+					},
+				},
+				"source":      trafficFilterEndpointAttribute("The source of the traffic"),
+				"destination": trafficFilterEndpointAttribute("The destination of the traffic"),
+			},
+		},
+	}
+}
+
+func (a *applianceUplinkSelectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_uplink_selection"
+}
+
+func (a *applianceUplinkSelectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the uplink selection settings of an MX appliance network, including the complete lists of WAN and VPN traffic uplink preferences. The defaults are restored and all preferences are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_uplink": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The default uplink, can be 'wan1' or 'wan2'. Defaults to 'wan1'",
+				Default:     stringdefault.StaticString("wan1"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("wan1", "wan2"),
+				},
+			},
+			"load_balancing_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether traffic is load balanced across both uplinks. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"active_active_auto_vpn_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether Auto VPN tunnels are formed over both uplinks. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"immediate_failover_and_failback_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether existing flows are moved immediately on uplink failover and failback. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"wan_traffic_uplink_preferences": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The uplink preferences of internet traffic, in order of priority",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"preferred_uplink": schema.StringAttribute{
+							Required:    true,
+							Description: "The preferred uplink, can be 'wan1' or 'wan2'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("wan1", "wan2"),
+							},
+						},
+						"traffic_filters": trafficFiltersAttribute(),
+					},
+				},
+			},
+			"vpn_traffic_uplink_preferences": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The uplink preferences of VPN traffic, in order of priority",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"preferred_uplink": schema.StringAttribute{
+							Required:    true,
+							Description: "The preferred uplink, can be 'wan1', 'wan2', 'bestForVoIP', 'loadBalancing' or 'defaultUplink'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("wan1", "wan2", "bestForVoIP", "loadBalancing", "defaultUplink"),
+							},
+						},
+						"fail_over_criterion": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "When traffic fails over to the other uplink, can be 'poorPerformance' or 'uplinkDown'. Defaults to 'poorPerformance'",
+							Default:     stringdefault.StaticString("poorPerformance"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("poorPerformance", "uplinkDown"),
+							},
+						},
+						"performance_class": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "The performance class used to evaluate the uplinks when preferred_uplink is 'wan1' or 'wan2' with 'poorPerformance' fail over, or 'loadBalancing'",
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Required:    true,
+									Description: "The type of the performance class, can be 'builtin' or 'custom'",
+									Validators: []validator.String{
+										stringvalidator.OneOf("builtin", "custom"),
+									},
+								},
+								"builtin_performance_class_name": schema.StringAttribute{
+									Optional:    true,
+									Description: "The name of the builtin performance class, e.g. 'VoIP'",
+								},
+								"custom_performance_class_id": schema.StringAttribute{
+									Optional:    true,
+									Description: "The ID of the custom performance class",
+								},
+							},
+						},
+						"traffic_filters": trafficFiltersAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceUplinkSelectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance uplink selection resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance uplink selection resource")
+}
+
+func (a *applianceUplinkSelectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceUplinkSelectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, preference := range config.WanTrafficUplinkPreferences {
+		filtersPath := path.Root("wan_traffic_uplink_preferences").AtListIndex(i).AtName("traffic_filters")
+		resp.Diagnostics.Append(validateTrafficFilters(filtersPath, preference.TrafficFilters, false)...)
+	}
+	for i, preference := range config.VpnTrafficUplinkPreferences {
+		preferencePath := path.Root("vpn_traffic_uplink_preferences").AtListIndex(i)
+		resp.Diagnostics.Append(validateTrafficFilters(preferencePath.AtName("traffic_filters"), preference.TrafficFilters, true)...)
+
+		class := preference.PerformanceClass
+		if class == nil || class.Type.IsUnknown() {
+			continue
+		}
+		builtin := class.Type.ValueString() == "builtin"
+		if builtin && class.BuiltinPerformanceClassName.IsNull() || !builtin && class.CustomPerformanceClassID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				preferencePath.AtName("performance_class"),
+				"Invalid VPN traffic uplink preference",
+				"builtin_performance_class_name is required for 'builtin' performance classes and custom_performance_class_id for 'custom' ones",
+			)
+		}
+	}
+}
+
+func validateTrafficFilters(filtersPath path.Path, filters []TrafficFilterModel, vpn bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, filter := range filters {
+		filterPath := filtersPath.AtListIndex(i)
+		if filter.Type.IsUnknown() {
+			continue
+		}
+		filterType := filter.Type.ValueString()
+		if filterType == "" {
+			filterType = "custom"
+		}
+
+		if filterType != "custom" {
+			if !vpn {
+				diags.AddAttributeError(filterPath.AtName("type"), "Invalid traffic filter", "WAN traffic filters only support the 'custom' type")
+				continue
+			}
+			if filter.ID.IsNull() || !filter.Protocol.IsNull() || filter.Source != nil || filter.Destination != nil {
+				diags.AddAttributeError(filterPath, "Invalid traffic filter", fmt.Sprintf("%s filters only support id", filterType))
+			}
+			continue
+		}
+
+		if !filter.ID.IsNull() {
+			diags.AddAttributeError(filterPath.AtName("id"), "Invalid traffic filter", "id can only be set for application and applicationCategory filters")
+		}
+		if filter.Protocol.IsNull() {
+			diags.AddAttributeError(filterPath.AtName("protocol"), "Invalid traffic filter", "protocol is required for custom filters")
+		}
+		for name, endpoint := range map[string]*TrafficFilterEndpointModel{"source": filter.Source, "destination": filter.Destination} {
+			if endpoint == nil {
+				continue
+			}
+			if !vpn && !endpoint.Network.IsNull() {
+				diags.AddAttributeError(filterPath.AtName(name).AtName("network"), "Invalid traffic filter", "network can only be set for VPN traffic filters")
+			}
+			if (!vpn || name == "source") && !endpoint.Fqdn.IsNull() {
+				diags.AddAttributeError(filterPath.AtName(name).AtName("fqdn"), "Invalid traffic filter", "fqdn can only be set for the destination of VPN traffic filters")
+			}
+			if !endpoint.Host.IsNull() && endpoint.Vlan.IsNull() {
+				diags.AddAttributeError(filterPath.AtName(name).AtName("host"), "Invalid traffic filter", "host requires vlan")
+			}
+		}
+	}
+	return diags
+}
+
+func (a *applianceUplinkSelectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance uplink selection resource")
+	var plan ApplianceUplinkSelectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selection, err := a.client.UpdateApplianceUplinkSelection(plan.NetworkID.ValueString(), uplinkSelectionToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance uplink selection", "Failed to update appliance uplink selection: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	uplinkSelectionFromAPI(selection, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance uplink selection resource")
+}
+
+func (a *applianceUplinkSelectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance uplink selection resource")
+	var state ApplianceUplinkSelectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selection, err := a.client.GetApplianceUplinkSelection(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance uplink selection", "Failed to get appliance uplink selection: "+err.Error())
+		return
+	}
+
+	uplinkSelectionFromAPI(selection, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance uplink selection resource")
+}
+
+func (a *applianceUplinkSelectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance uplink selection resource")
+	var plan ApplianceUplinkSelectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selection, err := a.client.UpdateApplianceUplinkSelection(plan.NetworkID.ValueString(), uplinkSelectionToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance uplink selection", "Failed to update appliance uplink selection: "+err.Error())
+		return
+	}
+
+	uplinkSelectionFromAPI(selection, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance uplink selection resource")
+}
+
+func (a *applianceUplinkSelectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance uplink selection resource")
+	var state ApplianceUplinkSelectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceUplinkSelection(state.NetworkID.ValueString(), uplinkSelectionToAPI(&ApplianceUplinkSelectionResourceModel{
+		DefaultUplink:        types.StringValue("wan1"),
+		LoadBalancingEnabled: types.BoolValue(true),
+	}))
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance uplink selection", "Failed to delete appliance uplink selection: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance uplink selection resource")
+}
+
+func (a *applianceUplinkSelectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func uplinkSelectionToAPI(plan *ApplianceUplinkSelectionResourceModel) *meraki.ApplianceUplinkSelection {
+	selection := &meraki.ApplianceUplinkSelection{
+		ActiveActiveAutoVpnEnabled:  plan.ActiveActiveAutoVpnEnabled.ValueBool(),
+		DefaultUplink:               plan.DefaultUplink.ValueString(),
+		LoadBalancingEnabled:        plan.LoadBalancingEnabled.ValueBool(),
+		WanTrafficUplinkPreferences: make([]meraki.ApplianceWanTrafficUplinkPreference, 0, len(plan.WanTrafficUplinkPreferences)),
+		VpnTrafficUplinkPreferences: make([]meraki.ApplianceVpnTrafficUplinkPreference, 0, len(plan.VpnTrafficUplinkPreferences)),
+	}
+	selection.FailoverAndFailback.Immediate.Enabled = plan.ImmediateFailoverAndFailbackEnabled.ValueBool()
+
+	for _, preference := range plan.WanTrafficUplinkPreferences {
+		selection.WanTrafficUplinkPreferences = append(selection.WanTrafficUplinkPreferences, meraki.ApplianceWanTrafficUplinkPreference{
+			PreferredUplink: preference.PreferredUplink.ValueString(),
+			TrafficFilters:  trafficFiltersToAPI(preference.TrafficFilters),
+		})
+	}
+	for _, preference := range plan.VpnTrafficUplinkPreferences {
+		p := meraki.ApplianceVpnTrafficUplinkPreference{
+			PreferredUplink:   preference.PreferredUplink.ValueString(),
+			FailOverCriterion: preference.FailOverCriterion.ValueString(),
+			TrafficFilters:    trafficFiltersToAPI(preference.TrafficFilters),
+		}
+		if class := preference.PerformanceClass; class != nil {
+			p.PerformanceClass = &meraki.ApplianceVpnTrafficPerformanceClass{
+				Type:                        class.Type.ValueString(),
+				BuiltinPerformanceClassName: class.BuiltinPerformanceClassName.ValueString(),
+				CustomPerformanceClassID:    class.CustomPerformanceClassID.ValueString(),
+			}
+		}
+		selection.VpnTrafficUplinkPreferences = append(selection.VpnTrafficUplinkPreferences, p)
+	}
+	return selection
+}
+
+func trafficFiltersToAPI(filters []TrafficFilterModel) []meraki.ApplianceTrafficFilter {
+	result := make([]meraki.ApplianceTrafficFilter, 0, len(filters))
+	for _, filter := range filters {
+		result = append(result, meraki.ApplianceTrafficFilter{
+			Type: filter.Type.ValueString(),
+			Value: meraki.ApplianceTrafficFilterValue{
+				ID:          filter.ID.ValueString(),
+				Protocol:    filter.Protocol.ValueString(),
+				Source:      trafficFilterEndpointToAPI(filter.Source),
+				Destination: trafficFilterEndpointToAPI(filter.Destination),
+			},
+		})
+	}
+	return result
+}
+
+func trafficFilterEndpointToAPI(endpoint *TrafficFilterEndpointModel) *meraki.ApplianceTrafficFilterEndpoint {
+	if endpoint == nil {
+		return nil
+	}
+	result := &meraki.ApplianceTrafficFilterEndpoint{
+		Port:    endpoint.Port.ValueString(),
+		Cidr:    endpoint.Cidr.ValueString(),
+		Network: endpoint.Network.ValueString(),
+		Fqdn:    endpoint.Fqdn.ValueString(),
+	}
+	if !endpoint.Vlan.IsNull() && !endpoint.Vlan.IsUnknown() {
+		vlan := endpoint.Vlan.ValueInt64()
+		result.Vlan = &vlan
+	}
+	if !endpoint.Host.IsNull() && !endpoint.Host.IsUnknown() {
+		host := endpoint.Host.ValueInt64()
+		result.Host = &host
+	}
+	return result
+}
+
+func uplinkSelectionFromAPI(selection *meraki.ApplianceUplinkSelection, state *ApplianceUplinkSelectionResourceModel) {
+	state.DefaultUplink = types.StringValue(selection.DefaultUplink)
+	state.LoadBalancingEnabled = types.BoolValue(selection.LoadBalancingEnabled)
+	state.ActiveActiveAutoVpnEnabled = types.BoolValue(selection.ActiveActiveAutoVpnEnabled)
+	state.ImmediateFailoverAndFailbackEnabled = types.BoolValue(selection.FailoverAndFailback.Immediate.Enabled)
+
+	var wan []WanTrafficUplinkPreferenceModel
+	for i, preference := range selection.WanTrafficUplinkPreferences {
+		var previous WanTrafficUplinkPreferenceModel
+		if i < len(state.WanTrafficUplinkPreferences) {
+			previous = state.WanTrafficUplinkPreferences[i]
+		}
+		wan = append(wan, WanTrafficUplinkPreferenceModel{
+			PreferredUplink: types.StringValue(preference.PreferredUplink),
+			TrafficFilters:  trafficFiltersFromAPI(previous.TrafficFilters, preference.TrafficFilters),
+		})
+	}
+	state.WanTrafficUplinkPreferences = wan
+
+	var vpn []VpnTrafficUplinkPreferenceModel
+	for i, preference := range selection.VpnTrafficUplinkPreferences {
+		var previous VpnTrafficUplinkPreferenceModel
+		if i < len(state.VpnTrafficUplinkPreferences) {
+			previous = state.VpnTrafficUplinkPreferences[i]
+		}
+		model := VpnTrafficUplinkPreferenceModel{
+			PreferredUplink:   types.StringValue(preference.PreferredUplink),
+			FailOverCriterion: types.StringValue(preference.FailOverCriterion),
+			TrafficFilters:    trafficFiltersFromAPI(previous.TrafficFilters, preference.TrafficFilters),
+		}
+		if preference.FailOverCriterion == "" {
+			model.FailOverCriterion = types.StringValue("poorPerformance")
+		}
+		if class := preference.PerformanceClass; class != nil && class.Type != "" {
+			model.PerformanceClass = &PerformanceClassModel{
+				Type:                        types.StringValue(class.Type),
+				BuiltinPerformanceClassName: helpers.StringValueOrNull(class.BuiltinPerformanceClassName),
+				CustomPerformanceClassID:    helpers.StringValueOrNull(class.CustomPerformanceClassID),
+			}
+		}
+		vpn = append(vpn, model)
+	}
+	state.VpnTrafficUplinkPreferences = vpn
+}
+
+func trafficFiltersFromAPI(prior []TrafficFilterModel, filters []meraki.ApplianceTrafficFilter) []TrafficFilterModel {
+	result := make([]TrafficFilterModel, 0, len(filters))
+	for i, filter := range filters {
+		var previous TrafficFilterModel
+		if i < len(prior) {
+			previous = prior[i]
+		}
+		result = append(result, TrafficFilterModel{
+			Type:        types.StringValue(filter.Type),
+			ID:          helpers.StringValueOrNull(filter.Value.ID),
+			Protocol:    anyOrNull(previous.Protocol, filter.Value.Protocol),
+			Source:      trafficFilterEndpointFromAPI(previous.Source, filter.Value.Source),
+			Destination: trafficFilterEndpointFromAPI(previous.Destination, filter.Value.Destination),
+		})
+	}
+	return result
+}
+
+// trafficFilterEndpointFromAPI converts a filter endpoint. The API reports unset ports and CIDRs as
+// "any", an endpoint that matches anything is only tracked when it was configured.
+func trafficFilterEndpointFromAPI(prior *TrafficFilterEndpointModel, endpoint *meraki.ApplianceTrafficFilterEndpoint) *TrafficFilterEndpointModel {
+	if endpoint == nil {
+		return nil
+	}
+	var previous TrafficFilterEndpointModel
+	if prior != nil {
+		previous = *prior
+	}
+
+	result := &TrafficFilterEndpointModel{
+		Port:    anyOrNull(previous.Port, endpoint.Port),
+		Cidr:    anyOrNull(previous.Cidr, endpoint.Cidr),
+		Network: helpers.StringValueOrNull(endpoint.Network),
+		Vlan:    types.Int64Null(),
+		Host:    types.Int64Null(),
+		Fqdn:    helpers.StringValueOrNull(endpoint.Fqdn),
+	}
+	if endpoint.Vlan != nil {
+		result.Vlan = types.Int64Value(*endpoint.Vlan)
+	}
+	if endpoint.Host != nil {
+		result.Host = types.Int64Value(*endpoint.Host)
+	}
+
+	if prior == nil && result.Port.IsNull() && result.Cidr.IsNull() && result.Network.IsNull() &&
+		result.Vlan.IsNull() && result.Host.IsNull() && result.Fqdn.IsNull() {
+		return nil
+	}
+	return result
+}
+
+// anyOrNull keeps a value null when it was not configured and the API reports the "any" default.
+func anyOrNull(prior types.String, value string) types.String {
+	if value == "" || (prior.IsNull() && strings.EqualFold(value, "any")) {
+		return types.StringNull()
+	}
+	return helpers.KeepCase(prior, value)
+}
@@ -0,0 +1,703 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &applianceVLANResource{}
+	_ resource.ResourceWithConfigure      = &applianceVLANResource{}
+	_ resource.ResourceWithImportState    = &applianceVLANResource{}
+	_ resource.ResourceWithValidateConfig = &applianceVLANResource{}
+)
+
+func NewApplianceVLANResource() resource.Resource {
+	return &applianceVLANResource{}
+}
+
+type applianceVLANResource struct {
+	client meraki.Client
+}
+
+type ApplianceVLANResourceModel struct {
+	ID                     types.String                      `tfsdk:"id"`
+	NetworkID              types.String                      `tfsdk:"network_id"`
+	VlanID                 types.Int64                       `tfsdk:"vlan_id"`
+	Name                   types.String                      `tfsdk:"name"`
+	Subnet                 types.String                      `tfsdk:"subnet"`
+	ApplianceIP            types.String                      `tfsdk:"appliance_ip"`
+	GroupPolicyID          types.String                      `tfsdk:"group_policy_id"`
+	DhcpHandling           types.String                      `tfsdk:"dhcp_handling"`
+	DhcpLeaseTime          types.String                      `tfsdk:"dhcp_lease_time"`
+	DhcpRelayServerIPs     []types.String                    `tfsdk:"dhcp_relay_server_ips"`
+	DhcpBootOptionsEnabled types.Bool                        `tfsdk:"dhcp_boot_options_enabled"`
+	DhcpBootNextServer     types.String                      `tfsdk:"dhcp_boot_next_server"`
+	DhcpBootFilename       types.String                      `tfsdk:"dhcp_boot_filename"`
+	DhcpOptions            []ApplianceVLANDhcpOptionModel    `tfsdk:"dhcp_options"`
+	FixedIPAssignments     map[string]FixedIPAssignmentModel `tfsdk:"fixed_ip_assignments"`
+	ReservedIPRanges       []ReservedIPRangeModel            `tfsdk:"reserved_ip_ranges"`
+	DNSNameservers         types.String                      `tfsdk:"dns_nameservers"`
+	IPv6                   *ApplianceVLANIPv6Model           `tfsdk:"ipv6"`
+}
+
+type ApplianceVLANDhcpOptionModel struct {
+	Code  types.String `tfsdk:"code"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type FixedIPAssignmentModel struct {
+	IP   types.String `tfsdk:"ip"`
+	Name types.String `tfsdk:"name"`
+}
+
+type ReservedIPRangeModel struct {
+	Start   types.String `tfsdk:"start"`
+	End     types.String `tfsdk:"end"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+type ApplianceVLANIPv6Model struct {
+	Enabled           types.Bool                               `tfsdk:"enabled"`
+	PrefixAssignments []ApplianceVLANIPv6PrefixAssignmentModel `tfsdk:"prefix_assignments"`
+}
+
+type ApplianceVLANIPv6PrefixAssignmentModel struct {
+	Autonomous         types.Bool     `tfsdk:"autonomous"`
+	StaticPrefix       types.String   `tfsdk:"static_prefix"`
+	StaticApplianceIP6 types.String   `tfsdk:"static_appliance_ip6"`
+	OriginType         types.String   `tfsdk:"origin_type"`
+	OriginInterfaces   []types.String `tfsdk:"origin_interfaces"`
+}
+
+func (a *applianceVLANResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_vlan"
+}
+
+func (a *applianceVLANResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a VLAN of an MX appliance network. VLANs must be enabled on the network, see ciscomeraki_appliance_vlans_settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/vlan_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network the VLAN belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The VLAN ID, between 1 and 4094",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the VLAN",
+			},
+			"subnet": schema.StringAttribute{
+				Required:    true,
+				Description: "The subnet of the VLAN in CIDR notation, e.g. '192.168.1.0/24'",
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"appliance_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "The local IP of the appliance on the VLAN, must be within the subnet",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"group_policy_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the group policy applied to the VLAN",
+			},
+			"dhcp_handling": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How the appliance handles DHCP requests on the VLAN, can be 'Run a DHCP server', 'Relay DHCP to another server' or 'Do not respond to DHCP requests'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("Run a DHCP server", "Relay DHCP to another server", "Do not respond to DHCP requests"),
+				},
+			},
+			"dhcp_lease_time": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The DHCP lease time, can be '30 minutes', '1 hour', '4 hours', '12 hours', '1 day' or '1 week'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("30 minutes", "1 hour", "4 hours", "12 hours", "1 day", "1 week"),
+				},
+			},
+			"dhcp_relay_server_ips": schema.ListAttribute{
+				Optional:    true,
+				Description: "The IPs of the DHCP servers requests are relayed to, when dhcp_handling is 'Relay DHCP to another server'",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IPv4Address()),
+				},
+			},
+			"dhcp_boot_options_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether DHCP boot options are sent to clients",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dhcp_boot_next_server": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PXE boot server address",
+			},
+			"dhcp_boot_filename": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PXE boot filename",
+			},
+			"dhcp_options": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Custom DHCP options sent to clients",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Required:    true,
+							Description: "The code of the DHCP option",
+						},
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of the DHCP option value, can be 'text', 'ip', 'hex' or 'integer'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("text", "ip", "hex", "integer"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "The value of the DHCP option",
+						},
+					},
+				},
+			},
+			"fixed_ip_assignments": fixedIPAssignmentsAttribute("DHCP fixed IP assignments keyed by client MAC address"),
+			"reserved_ip_ranges":   reservedIPRangesAttribute("IP ranges the DHCP server will not hand out"),
+			"dns_nameservers": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The DNS nameservers handed out to clients, can be 'upstream_dns', 'google_dns', 'opendns', or a newline separated list of IPs",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv6": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "IPv6 settings of the VLAN",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether IPv6 is enabled on the VLAN",
+					},
+					"prefix_assignments": schema.ListNestedAttribute{
+						Optional:    true,
+						Description: "The IPv6 prefix assignments of the VLAN",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"autonomous": schema.BoolAttribute{
+									Required:    true,
+									Description: "Whether the prefix is assigned automatically",
+								},
+								"static_prefix": schema.StringAttribute{
+									Optional:    true,
+									Description: "The manual IPv6 prefix, when autonomous is false",
+									Validators: []validator.String{
+										validators.IPv6CIDR(),
+									},
+								},
+								"static_appliance_ip6": schema.StringAttribute{
+									Optional:    true,
+									Description: "The manual IPv6 address of the appliance on the VLAN",
+									Validators: []validator.String{
+										validators.IPv6Address(),
+									},
+								},
+								"origin_type": schema.StringAttribute{
+									Required:    true,
+									Description: "The origin of the prefix, can be 'internet' or 'independent'",
+									Validators: []validator.String{
+										stringvalidator.OneOf("internet", "independent"),
+									},
+								},
+								"origin_interfaces": schema.ListAttribute{
+									Optional:    true,
+									Description: "The uplink interfaces the prefix is delegated from, e.g. 'wan1'",
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fixedIPAssignmentsAttribute(description string) schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(validators.MACAddress()),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"ip": schema.StringAttribute{
+					Required:    true,
+					Description: "The IP address assigned to the client",
+					Validators: []validator.String{
+						validators.IPv4Address(),
+					},
+				},
+				"name": schema.StringAttribute{
+					Optional:    true,
+					Description: "A name for the client",
+				},
+			},
+		},
+	}
+}
+
+func reservedIPRangesAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:    true,
+		Description: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start": schema.StringAttribute{
+					Required:    true,
+					Description: "The first IP of the reserved range",
+					Validators: []validator.String{
+						validators.IPv4Address(),
+					},
+				},
+				"end": schema.StringAttribute{
+					Required:    true,
+					Description: "The last IP of the reserved range",
+					Validators: []validator.String{
+						validators.IPv4Address(),
+					},
+				},
+				"comment": schema.StringAttribute{
+					Required:    true,
+					Description: "A comment describing the reserved range",
+				},
+			},
+		},
+	}
+}
+
+func (a *applianceVLANResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance VLAN resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance VLAN resource")
+}
+
+func (a *applianceVLANResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplianceVLANResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Subnet.IsUnknown() || config.Subnet.IsNull() || config.ApplianceIP.IsUnknown() || config.ApplianceIP.IsNull() {
+		return
+	}
+
+	_, subnet, err := net.ParseCIDR(config.Subnet.ValueString())
+	ip := net.ParseIP(config.ApplianceIP.ValueString())
+	if err != nil || ip == nil {
+		// reported by the attribute validators
+		return
+	}
+	if !subnet.Contains(ip) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("appliance_ip"),
+			"Invalid appliance IP",
+			fmt.Sprintf("appliance_ip %s is not within subnet %s", ip, subnet),
+		)
+	}
+}
+
+func (a *applianceVLANResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance VLAN resource")
+	var plan ApplianceVLANResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := plan.NetworkID.ValueString()
+	vlanID := strconv.FormatInt(plan.VlanID.ValueInt64(), 10)
+	vlanReqData := vlanToAPI(&plan, nil)
+
+	// DHCP settings can only be set by updating the VLAN after it has been created
+	created, err := a.client.CreateApplianceVLAN(networkID, &meraki.ApplianceVLANCreateRequest{
+		ID:            vlanID,
+		Name:          vlanReqData.Name,
+		Subnet:        vlanReqData.Subnet,
+		ApplianceIP:   vlanReqData.ApplianceIP,
+		GroupPolicyID: plan.GroupPolicyID.ValueString(),
+		IPv6:          vlanReqData.IPv6,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create appliance VLAN", "Failed to create appliance VLAN: "+err.Error())
+		return
+	}
+	plan.ID = types.StringValue(networkID + "/" + vlanID)
+
+	// save the created VLAN first, so it is tainted instead of orphaned when the update fails
+	createdState := plan
+	vlanFromAPI(created, &createdState)
+	resp.Diagnostics.Append(resp.State.Set(ctx, createdState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlan, err := a.client.UpdateApplianceVLAN(networkID, vlanID, vlanReqData)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance VLAN", "Failed to update appliance VLAN: "+err.Error())
+		return
+	}
+
+	vlanFromAPI(vlan, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance VLAN resource")
+}
+
+func (a *applianceVLANResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance VLAN resource")
+	var state ApplianceVLANResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlan, err := a.client.GetApplianceVLAN(state.NetworkID.ValueString(), strconv.FormatInt(state.VlanID.ValueInt64(), 10))
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance VLAN", "Failed to get appliance VLAN: "+err.Error())
+		return
+	}
+
+	vlanFromAPI(vlan, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance VLAN resource")
+}
+
+func (a *applianceVLANResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance VLAN resource")
+	var plan, state ApplianceVLANResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlan, err := a.client.UpdateApplianceVLAN(plan.NetworkID.ValueString(), strconv.FormatInt(plan.VlanID.ValueInt64(), 10), vlanToAPI(&plan, &state))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance VLAN", "Failed to update appliance VLAN: "+err.Error())
+		return
+	}
+
+	vlanFromAPI(vlan, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance VLAN resource")
+}
+
+func (a *applianceVLANResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance VLAN resource")
+	var state ApplianceVLANResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := a.client.DeleteApplianceVLAN(state.NetworkID.ValueString(), strconv.FormatInt(state.VlanID.ValueInt64(), 10))
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete appliance VLAN", "Failed to delete appliance VLAN: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance VLAN resource")
+}
+
+func (a *applianceVLANResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, vlanID, err := helpers.SplitImportID(req.ID, "network_id/vlan_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	id, err := strconv.ParseInt(vlanID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("vlan_id must be a number, got: %s", vlanID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vlan_id"), id)...)
+}
+
+// vlanToAPI builds the VLAN update request. The API keeps the group policy, DHCP relay, PXE boot and
+// IPv6 settings when they are left out, so they are explicitly cleared when prior, the current state,
+// still has them.
+func vlanToAPI(plan *ApplianceVLANResourceModel, prior *ApplianceVLANResourceModel) *meraki.ApplianceVLAN {
+	if prior == nil {
+		prior = &ApplianceVLANResourceModel{}
+	}
+	vlan := &meraki.ApplianceVLAN{
+		Name:               plan.Name.ValueString(),
+		Subnet:             plan.Subnet.ValueString(),
+		ApplianceIP:        plan.ApplianceIP.ValueString(),
+		GroupPolicyID:      clearableStringToAPI(plan.GroupPolicyID, prior.GroupPolicyID),
+		DhcpHandling:       plan.DhcpHandling.ValueString(),
+		DhcpLeaseTime:      plan.DhcpLeaseTime.ValueString(),
+		DhcpBootNextServer: clearableStringToAPI(plan.DhcpBootNextServer, prior.DhcpBootNextServer),
+		DhcpBootFilename:   clearableStringToAPI(plan.DhcpBootFilename, prior.DhcpBootFilename),
+		DhcpOptions:        make([]meraki.ApplianceVLANDhcpOption, 0, len(plan.DhcpOptions)),
+		FixedIPAssignments: fixedIPAssignmentsToAPI(plan.FixedIPAssignments),
+		ReservedIPRanges:   reservedIPRangesToAPI(plan.ReservedIPRanges),
+		DNSNameservers:     plan.DNSNameservers.ValueString(),
+	}
+	if plan.DhcpRelayServerIPs != nil || prior.DhcpRelayServerIPs != nil {
+		relayServerIPs := helpers.FromStringValues(plan.DhcpRelayServerIPs)
+		vlan.DhcpRelayServerIPs = &relayServerIPs
+	}
+	if !plan.DhcpBootOptionsEnabled.IsNull() && !plan.DhcpBootOptionsEnabled.IsUnknown() {
+		enabled := plan.DhcpBootOptionsEnabled.ValueBool()
+		vlan.DhcpBootOptionsEnabled = &enabled
+	}
+	for _, option := range plan.DhcpOptions {
+		vlan.DhcpOptions = append(vlan.DhcpOptions, meraki.ApplianceVLANDhcpOption{
+			Code:  option.Code.ValueString(),
+			Type:  option.Type.ValueString(),
+			Value: option.Value.ValueString(),
+		})
+	}
+	if plan.IPv6 != nil {
+		vlan.IPv6 = &meraki.ApplianceVLANIPv6{
+			Enabled: plan.IPv6.Enabled.ValueBool(),
+		}
+		for _, assignment := range plan.IPv6.PrefixAssignments {
+			vlan.IPv6.PrefixAssignments = append(vlan.IPv6.PrefixAssignments, meraki.ApplianceVLANIPv6PrefixAssignment{
+				Autonomous:         assignment.Autonomous.ValueBool(),
+				StaticPrefix:       assignment.StaticPrefix.ValueString(),
+				StaticApplianceIP6: assignment.StaticApplianceIP6.ValueString(),
+				Origin: meraki.ApplianceVLANIPv6Origin{
+					Type:       assignment.OriginType.ValueString(),
+					Interfaces: helpers.FromStringValues(assignment.OriginInterfaces),
+				},
+			})
+		}
+	} else if prior.IPv6 != nil {
+		vlan.IPv6 = &meraki.ApplianceVLANIPv6{Enabled: false}
+	}
+	return vlan
+}
+
+// clearableStringToAPI returns the planned value, or an empty string to clear a value removed since prior.
+func clearableStringToAPI(value types.String, prior types.String) *string {
+	if !value.IsNull() {
+		v := value.ValueString()
+		return &v
+	}
+	if !prior.IsNull() {
+		empty := ""
+		return &empty
+	}
+	return nil
+}
+
+func vlanFromAPI(vlan *meraki.ApplianceVLAN, state *ApplianceVLANResourceModel) {
+	state.Name = types.StringValue(vlan.Name)
+	state.Subnet = types.StringValue(vlan.Subnet)
+	state.ApplianceIP = types.StringValue(vlan.ApplianceIP)
+	state.GroupPolicyID = types.StringNull()
+	if vlan.GroupPolicyID != nil {
+		state.GroupPolicyID = helpers.StringValueOrNull(*vlan.GroupPolicyID)
+	}
+	state.DhcpHandling = types.StringValue(vlan.DhcpHandling)
+	state.DhcpLeaseTime = helpers.StringValueOrNull(vlan.DhcpLeaseTime)
+	state.DhcpBootNextServer = types.StringNull()
+	if vlan.DhcpBootNextServer != nil {
+		state.DhcpBootNextServer = helpers.StringValueOrNull(*vlan.DhcpBootNextServer)
+	}
+	state.DhcpBootFilename = types.StringNull()
+	if vlan.DhcpBootFilename != nil {
+		state.DhcpBootFilename = helpers.StringValueOrNull(*vlan.DhcpBootFilename)
+	}
+	state.DNSNameservers = helpers.StringValueOrNull(vlan.DNSNameservers)
+	state.DhcpBootOptionsEnabled = types.BoolValue(vlan.DhcpBootOptionsEnabled != nil && *vlan.DhcpBootOptionsEnabled)
+
+	state.DhcpRelayServerIPs = nil
+	if vlan.DhcpRelayServerIPs != nil {
+		state.DhcpRelayServerIPs = helpers.NilIfEmpty(helpers.ToStringValues(*vlan.DhcpRelayServerIPs))
+	}
+
+	state.DhcpOptions = nil
+	for _, option := range vlan.DhcpOptions {
+		state.DhcpOptions = append(state.DhcpOptions, ApplianceVLANDhcpOptionModel{
+			Code:  types.StringValue(option.Code),
+			Type:  types.StringValue(option.Type),
+			Value: types.StringValue(option.Value),
+		})
+	}
+
+	state.FixedIPAssignments = fixedIPAssignmentsFromAPI(state.FixedIPAssignments, vlan.FixedIPAssignments)
+
+	state.ReservedIPRanges = reservedIPRangesFromAPI(vlan.ReservedIPRanges)
+
+	// IPv6 is reported even when disabled, only track it once it is configured
+	if vlan.IPv6 != nil && (state.IPv6 != nil || vlan.IPv6.Enabled) {
+		ipv6 := &ApplianceVLANIPv6Model{
+			Enabled: types.BoolValue(vlan.IPv6.Enabled),
+		}
+		for _, assignment := range vlan.IPv6.PrefixAssignments {
+			ipv6.PrefixAssignments = append(ipv6.PrefixAssignments, ApplianceVLANIPv6PrefixAssignmentModel{
+				Autonomous:         types.BoolValue(assignment.Autonomous),
+				StaticPrefix:       helpers.StringValueOrNull(assignment.StaticPrefix),
+				StaticApplianceIP6: helpers.StringValueOrNull(assignment.StaticApplianceIP6),
+				OriginType:         types.StringValue(assignment.Origin.Type),
+				OriginInterfaces:   helpers.NilIfEmpty(helpers.ToStringValues(assignment.Origin.Interfaces)),
+			})
+		}
+		state.IPv6 = ipv6
+	}
+}
+
+func fixedIPAssignmentsToAPI(assignments map[string]FixedIPAssignmentModel) map[string]meraki.FixedIPAssignment {
+	result := make(map[string]meraki.FixedIPAssignment, len(assignments))
+	for mac, assignment := range assignments {
+		result[mac] = meraki.FixedIPAssignment{
+			IP:   assignment.IP.ValueString(),
+			Name: assignment.Name.ValueString(),
+		}
+	}
+	return result
+}
+
+// fixedIPAssignmentsFromAPI keys the assignments by the MAC addresses of prior, so a MAC address
+// configured in another case or format than the API reports it is kept.
+func fixedIPAssignmentsFromAPI(prior map[string]FixedIPAssignmentModel, assignments map[string]meraki.FixedIPAssignment) map[string]FixedIPAssignmentModel {
+	if len(assignments) == 0 {
+		return nil
+	}
+	priorMACs := make(map[string]string, len(prior))
+	for mac := range prior {
+		priorMACs[normalizeMAC(mac)] = mac
+	}
+
+	result := make(map[string]FixedIPAssignmentModel, len(assignments))
+	for mac, assignment := range assignments {
+		if priorMAC, ok := priorMACs[normalizeMAC(mac)]; ok {
+			mac = priorMAC
+		}
+		result[mac] = FixedIPAssignmentModel{
+			IP:   types.StringValue(assignment.IP),
+			Name: helpers.StringValueOrNull(assignment.Name),
+		}
+	}
+	return result
+}
+
+func normalizeMAC(mac string) string {
+	if hw, err := net.ParseMAC(mac); err == nil {
+		return hw.String()
+	}
+	return strings.ToLower(mac)
+}
+
+func reservedIPRangesToAPI(ranges []ReservedIPRangeModel) []meraki.ReservedIPRange {
+	result := make([]meraki.ReservedIPRange, 0, len(ranges))
+	for _, r := range ranges {
+		result = append(result, meraki.ReservedIPRange{
+			Start:   r.Start.ValueString(),
+			End:     r.End.ValueString(),
+			Comment: r.Comment.ValueString(),
+		})
+	}
+	return result
+}
+
+func reservedIPRangesFromAPI(ranges []meraki.ReservedIPRange) []ReservedIPRangeModel {
+	var result []ReservedIPRangeModel
+	for _, r := range ranges {
+		result = append(result, ReservedIPRangeModel{
+			Start:   types.StringValue(r.Start),
+			End:     types.StringValue(r.End),
+			Comment: types.StringValue(r.Comment),
+		})
+	}
+	return result
+}
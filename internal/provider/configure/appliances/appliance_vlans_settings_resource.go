@@ -0,0 +1,184 @@
+package appliances
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &applianceVLANsSettingsResource{}
+	_ resource.ResourceWithConfigure   = &applianceVLANsSettingsResource{}
+	_ resource.ResourceWithImportState = &applianceVLANsSettingsResource{}
+)
+
+func NewApplianceVLANsSettingsResource() resource.Resource {
+	return &applianceVLANsSettingsResource{}
+}
+
+type applianceVLANsSettingsResource struct {
+	client meraki.Client
+}
+
+type ApplianceVLANsSettingsResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	NetworkID    types.String `tfsdk:"network_id"`
+	VlansEnabled types.Bool   `tfsdk:"vlans_enabled"`
+}
+
+func (a *applianceVLANsSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance_vlans_settings"
+}
+
+func (a *applianceVLANsSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables or disables VLANs on an MX appliance network. VLANs are disabled again on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlans_enabled": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether VLANs are enabled on the network",
+			},
+		},
+	}
+}
+
+func (a *applianceVLANsSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the appliance VLANs settings resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+	tflog.Info(ctx, "Configured the appliance VLANs settings resource")
+}
+
+func (a *applianceVLANsSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the appliance VLANs settings resource")
+	var plan ApplianceVLANsSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := a.client.UpdateApplianceVLANsSettings(plan.NetworkID.ValueString(), &meraki.ApplianceVLANsSettings{
+		VlansEnabled: plan.VlansEnabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance VLANs settings", "Failed to update appliance VLANs settings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	plan.VlansEnabled = types.BoolValue(settings.VlansEnabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the appliance VLANs settings resource")
+}
+
+func (a *applianceVLANsSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the appliance VLANs settings resource")
+	var state ApplianceVLANsSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := a.client.GetApplianceVLANsSettings(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get appliance VLANs settings", "Failed to get appliance VLANs settings: "+err.Error())
+		return
+	}
+
+	state.VlansEnabled = types.BoolValue(settings.VlansEnabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the appliance VLANs settings resource")
+}
+
+func (a *applianceVLANsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the appliance VLANs settings resource")
+	var plan ApplianceVLANsSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := a.client.UpdateApplianceVLANsSettings(plan.NetworkID.ValueString(), &meraki.ApplianceVLANsSettings{
+		VlansEnabled: plan.VlansEnabled.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update appliance VLANs settings", "Failed to update appliance VLANs settings: "+err.Error())
+		return
+	}
+
+	plan.VlansEnabled = types.BoolValue(settings.VlansEnabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the appliance VLANs settings resource")
+}
+
+func (a *applianceVLANsSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the appliance VLANs settings resource")
+	var state ApplianceVLANsSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.UpdateApplianceVLANsSettings(state.NetworkID.ValueString(), &meraki.ApplianceVLANsSettings{
+		VlansEnabled: false,
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to disable appliance VLANs", "Failed to disable appliance VLANs: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the appliance VLANs settings resource")
+}
+
+func (a *applianceVLANsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
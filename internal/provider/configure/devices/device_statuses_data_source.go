@@ -0,0 +1,213 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &deviceStatusesDataSource{}
+	_ datasource.DataSourceWithConfigure        = &deviceStatusesDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &deviceStatusesDataSource{}
+)
+
+func NewDeviceStatusesDataSource() datasource.DataSource {
+	return &deviceStatusesDataSource{}
+}
+
+type deviceStatusesDataSource struct {
+	client meraki.Client
+}
+
+type deviceStatusesDataSourceModel struct {
+	OrgID          types.String        `tfsdk:"org_id"`
+	NetworkID      types.String        `tfsdk:"network_id"`
+	Models         []types.String      `tfsdk:"models"`
+	ProductTypes   []types.String      `tfsdk:"product_types"`
+	Tags           []types.String      `tfsdk:"tags"`
+	TagsFilterType types.String        `tfsdk:"tags_filter_type"`
+	Statuses       []types.String      `tfsdk:"statuses"`
+	Devices        []deviceStatusModel `tfsdk:"devices"`
+}
+
+type deviceStatusModel struct {
+	Serial         types.String   `tfsdk:"serial"`
+	Name           types.String   `tfsdk:"name"`
+	Model          types.String   `tfsdk:"model"`
+	Mac            types.String   `tfsdk:"mac"`
+	NetworkID      types.String   `tfsdk:"network_id"`
+	ProductType    types.String   `tfsdk:"product_type"`
+	Status         types.String   `tfsdk:"status"`
+	LastReportedAt types.String   `tfsdk:"last_reported_at"`
+	PublicIP       types.String   `tfsdk:"public_ip"`
+	LanIP          types.String   `tfsdk:"lan_ip"`
+	Gateway        types.String   `tfsdk:"gateway"`
+	Tags           []types.String `tfsdk:"tags"`
+}
+
+func (d *deviceStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_statuses"
+}
+
+func (d *deviceStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the device statuses data source")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Info(ctx, "Configured the device statuses data source")
+}
+
+func (d *deviceStatusesDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("org_id"),
+			path.MatchRoot("network_id"),
+		),
+	}
+}
+
+func (d *deviceStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := deviceFilterAttributes()
+	attributes["statuses"] = schema.ListAttribute{
+		Optional:    true,
+		Description: "Only return devices with these statuses, can be 'online', 'alerting', 'offline' or 'dormant'",
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(stringvalidator.OneOf("online", "alerting", "offline", "dormant")),
+		},
+	}
+	attributes["devices"] = schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The statuses of the devices matching the filters",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"serial": schema.StringAttribute{
+					Computed:    true,
+					Description: "The serial number of the device",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "The name of the device",
+				},
+				"model": schema.StringAttribute{
+					Computed:    true,
+					Description: "The model of the device",
+				},
+				"mac": schema.StringAttribute{
+					Computed:    true,
+					Description: "The MAC address of the device",
+				},
+				"network_id": schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID of the network the device belongs to",
+				},
+				"product_type": schema.StringAttribute{
+					Computed:    true,
+					Description: "The product type of the device",
+				},
+				"status": schema.StringAttribute{
+					Computed:    true,
+					Description: "The status of the device, can be 'online', 'alerting', 'offline' or 'dormant'",
+				},
+				"last_reported_at": schema.StringAttribute{
+					Computed:    true,
+					Description: "When the device last reported to the Meraki cloud",
+				},
+				"public_ip": schema.StringAttribute{
+					Computed:    true,
+					Description: "The public IP address of the device",
+				},
+				"lan_ip": schema.StringAttribute{
+					Computed:    true,
+					Description: "The LAN IP address of the device",
+				},
+				"gateway": schema.StringAttribute{
+					Computed:    true,
+					Description: "The IP address of the gateway of the device",
+				},
+				"tags": schema.ListAttribute{
+					Computed:    true,
+					Description: "The tags of the device",
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (d *deviceStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading the device statuses data source")
+	var state deviceStatusesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID, err := resolveOrgID(d.client, state.OrgID, state.NetworkID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get network", "failed to get network: "+err.Error())
+		return
+	}
+
+	filter := &meraki.DeviceStatusesFilter{
+		DevicesFilter: *newDevicesFilter(state.NetworkID, state.Models, state.ProductTypes, state.Tags, state.TagsFilterType),
+		Statuses:      helpers.FromStringValues(state.Statuses),
+	}
+	statuses, err := d.client.GetOrganizationDeviceStatuses(orgID, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get device statuses", "failed to get device statuses: "+err.Error())
+		return
+	}
+
+	state.Devices = make([]deviceStatusModel, 0, len(statuses))
+	for _, status := range statuses {
+		state.Devices = append(state.Devices, deviceStatusModel{
+			Serial:         types.StringValue(status.Serial),
+			Name:           types.StringValue(status.Name),
+			Model:          types.StringValue(status.Model),
+			Mac:            types.StringValue(status.Mac),
+			NetworkID:      types.StringValue(status.NetworkID),
+			ProductType:    types.StringValue(status.ProductType),
+			Status:         types.StringValue(status.Status),
+			LastReportedAt: types.StringValue(status.LastReportedAt),
+			PublicIP:       types.StringValue(status.PublicIP),
+			LanIP:          types.StringValue(status.LanIP),
+			Gateway:        types.StringValue(status.Gateway),
+			Tags:           helpers.ToStringValues(status.Tags),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the device statuses data source")
+}
@@ -0,0 +1,258 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = &devicesDataSource{}
+	_ datasource.DataSourceWithConfigure        = &devicesDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &devicesDataSource{}
+)
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &devicesDataSource{}
+}
+
+type devicesDataSource struct {
+	client meraki.Client
+}
+
+type devicesDataSourceModel struct {
+	OrgID          types.String   `tfsdk:"org_id"`
+	NetworkID      types.String   `tfsdk:"network_id"`
+	Models         []types.String `tfsdk:"models"`
+	ProductTypes   []types.String `tfsdk:"product_types"`
+	Tags           []types.String `tfsdk:"tags"`
+	TagsFilterType types.String   `tfsdk:"tags_filter_type"`
+	Devices        []deviceModel  `tfsdk:"devices"`
+}
+
+type deviceModel struct {
+	Serial      types.String   `tfsdk:"serial"`
+	Name        types.String   `tfsdk:"name"`
+	Model       types.String   `tfsdk:"model"`
+	Mac         types.String   `tfsdk:"mac"`
+	LanIP       types.String   `tfsdk:"lan_ip"`
+	Firmware    types.String   `tfsdk:"firmware"`
+	NetworkID   types.String   `tfsdk:"network_id"`
+	ProductType types.String   `tfsdk:"product_type"`
+	Address     types.String   `tfsdk:"address"`
+	Notes       types.String   `tfsdk:"notes"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+func (d *devicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_devices"
+}
+
+func (d *devicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the devices data source")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+	tflog.Info(ctx, "Configured the devices data source")
+}
+
+func (d *devicesDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("org_id"),
+			path.MatchRoot("network_id"),
+		),
+	}
+}
+
+func (d *devicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := deviceFilterAttributes()
+	attributes["devices"] = schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The devices matching the filters",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"serial": schema.StringAttribute{
+					Computed:    true,
+					Description: "The serial number of the device",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "The name of the device",
+				},
+				"model": schema.StringAttribute{
+					Computed:    true,
+					Description: "The model of the device",
+				},
+				"mac": schema.StringAttribute{
+					Computed:    true,
+					Description: "The MAC address of the device",
+				},
+				"lan_ip": schema.StringAttribute{
+					Computed:    true,
+					Description: "The LAN IP address of the device",
+				},
+				"firmware": schema.StringAttribute{
+					Computed:    true,
+					Description: "The firmware version of the device",
+				},
+				"network_id": schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID of the network the device belongs to",
+				},
+				"product_type": schema.StringAttribute{
+					Computed:    true,
+					Description: "The product type of the device",
+				},
+				"address": schema.StringAttribute{
+					Computed:    true,
+					Description: "The physical address of the device",
+				},
+				"notes": schema.StringAttribute{
+					Computed:    true,
+					Description: "The notes of the device",
+				},
+				"tags": schema.ListAttribute{
+					Computed:    true,
+					Description: "The tags of the device",
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading the devices data source")
+	var state devicesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID, err := resolveOrgID(d.client, state.OrgID, state.NetworkID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get network", "failed to get network: "+err.Error())
+		return
+	}
+
+	filter := newDevicesFilter(state.NetworkID, state.Models, state.ProductTypes, state.Tags, state.TagsFilterType)
+	devices, err := d.client.GetOrganizationDevices(orgID, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get devices", "failed to get devices: "+err.Error())
+		return
+	}
+
+	state.Devices = make([]deviceModel, 0, len(devices))
+	for _, device := range devices {
+		state.Devices = append(state.Devices, deviceModel{
+			Serial:      types.StringValue(device.Serial),
+			Name:        types.StringValue(device.Name),
+			Model:       types.StringValue(device.Model),
+			Mac:         types.StringValue(device.Mac),
+			LanIP:       types.StringValue(device.LanIP),
+			Firmware:    types.StringValue(device.Firmware),
+			NetworkID:   types.StringValue(device.NetworkID),
+			ProductType: types.StringValue(device.ProductType),
+			Address:     types.StringValue(device.Address),
+			Notes:       types.StringValue(device.Notes),
+			Tags:        helpers.ToStringValues(device.Tags),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the devices data source")
+}
+
+// deviceFilterAttributes returns the filter attributes shared by the device data sources.
+func deviceFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"org_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of the organization to read devices from. Exactly one of org_id or network_id must be set",
+		},
+		"network_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of the network to read devices from. Exactly one of org_id or network_id must be set",
+		},
+		"models": schema.ListAttribute{
+			Optional:    true,
+			Description: "Only return devices of these models",
+			ElementType: types.StringType,
+		},
+		"product_types": schema.ListAttribute{
+			Optional:    true,
+			Description: "Only return devices of these product types, e.g. 'appliance', 'switch' or 'wireless'",
+			ElementType: types.StringType,
+		},
+		"tags": schema.ListAttribute{
+			Optional:    true,
+			Description: "Only return devices with these tags",
+			ElementType: types.StringType,
+		},
+		"tags_filter_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "How the tags filter is applied, can be 'withAnyTags' or 'withAllTags'. Defaults to 'withAnyTags'",
+			Validators: []validator.String{
+				stringvalidator.OneOf("withAnyTags", "withAllTags"),
+			},
+		},
+	}
+}
+
+// resolveOrgID returns the organization to query, looking it up from the network when only network_id is set.
+func resolveOrgID(client meraki.Client, orgID types.String, networkID types.String) (string, error) {
+	if !orgID.IsNull() {
+		return orgID.ValueString(), nil
+	}
+	network, err := client.GetNetwork(networkID.ValueString())
+	if err != nil {
+		return "", err
+	}
+	if network.OrgID == "" {
+		return "", fmt.Errorf("network %s not found", networkID.ValueString())
+	}
+	return network.OrgID, nil
+}
+
+func newDevicesFilter(networkID types.String, models, productTypes, tags []types.String, tagsFilterType types.String) *meraki.DevicesFilter {
+	filter := &meraki.DevicesFilter{
+		Models:         helpers.FromStringValues(models),
+		ProductTypes:   helpers.FromStringValues(productTypes),
+		Tags:           helpers.FromStringValues(tags),
+		TagsFilterType: tagsFilterType.ValueString(),
+	}
+	if !networkID.IsNull() {
+		filter.NetworkIDs = []string{networkID.ValueString()}
+	}
+	return filter
+}
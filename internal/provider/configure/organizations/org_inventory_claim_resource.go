@@ -0,0 +1,304 @@
+package organizations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	"strings"
+)
+
+var (
+	_ resource.Resource              = &organizationInventoryClaimResource{}
+	_ resource.ResourceWithConfigure = &organizationInventoryClaimResource{}
+)
+
+func NewOrganizationInventoryClaimResource() resource.Resource {
+	return &organizationInventoryClaimResource{}
+}
+
+type organizationInventoryClaimResource struct {
+	client meraki.Client
+}
+
+type OrganizationInventoryClaimResourceModel struct {
+	ID       types.String                        `tfsdk:"id"`
+	OrgID    types.String                        `tfsdk:"org_id"`
+	Orders   types.Set                           `tfsdk:"orders"`
+	Serials  types.Set                           `tfsdk:"serials"`
+	Licenses []OrganizationInventoryLicenseModel `tfsdk:"licenses"`
+}
+
+type OrganizationInventoryLicenseModel struct {
+	Key  types.String `tfsdk:"key"`
+	Mode types.String `tfsdk:"mode"`
+}
+
+func (o *organizationInventoryClaimResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_inventory_claim"
+}
+
+func (o *organizationInventoryClaimResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Claims orders, devices and licenses into an organization inventory. The claimed serials are released from the inventory on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the claim in the form org_id/hash, where the hash is derived from the orders, serials and licenses claimed on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the organization to claim into",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"orders": schema.SetAttribute{
+				Optional:    true,
+				Description: "The order numbers to claim. Orders cannot be released, so changing them replaces the claim",
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"serials": schema.SetAttribute{
+				Optional:    true,
+				Description: "The serials of the devices to claim. Removed serials are released from the inventory",
+				ElementType: types.StringType,
+			},
+			"licenses": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The licenses to claim",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "The license key",
+						},
+						"mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Co-term licensing only: either 'renew' or 'addDevices'. Defaults to 'addDevices'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("addDevices", "renew"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (o *organizationInventoryClaimResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the organization inventory claim resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	o.client = client
+	tflog.Info(ctx, "Configured the organization inventory claim resource")
+}
+
+func (o *organizationInventoryClaimResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the organization inventory claim resource")
+	var plan OrganizationInventoryClaimResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claim := &meraki.InventoryClaimRequest{}
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, plan.Orders, &claim.Orders)...)
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, plan.Serials, &claim.Serials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, license := range plan.Licenses {
+		claim.Licenses = append(claim.Licenses, meraki.InventoryLicense{
+			Key:  license.Key.ValueString(),
+			Mode: license.Mode.ValueString(),
+		})
+	}
+
+	err := o.client.ClaimIntoOrganizationInventory(plan.OrgID.ValueString(), claim)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to claim into organization inventory",
+			"Failed to claim into organization inventory: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(inventoryClaimID(plan.OrgID.ValueString(), claim))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the organization inventory claim resource")
+}
+
+func (o *organizationInventoryClaimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the organization inventory claim resource")
+	var state OrganizationInventoryClaimResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serials []string
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, state.Serials, &serials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(serials) == 0 {
+		return
+	}
+
+	devices, err := o.client.GetOrganizationInventoryDevices(state.OrgID.ValueString(), &meraki.InventoryDevicesFilter{
+		Serials: serials,
+	})
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get organization inventory",
+			"Failed to get organization inventory: "+err.Error(),
+		)
+		return
+	}
+
+	// drop serials that were released outside of terraform
+	claimed := make([]string, 0, len(devices))
+	for _, device := range devices {
+		claimed = append(claimed, device.Serial)
+	}
+	claimedSet, diags := types.SetValueFrom(ctx, types.StringType, claimed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Serials = claimedSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the organization inventory claim resource")
+}
+
+func (o *organizationInventoryClaimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the organization inventory claim resource")
+	var plan, state OrganizationInventoryClaimResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planSerials, stateSerials []string
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, plan.Serials, &planSerials)...)
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, state.Serials, &stateSerials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added := helpers.Difference(planSerials, stateSerials)
+	removed := helpers.Difference(stateSerials, planSerials)
+
+	if len(added) > 0 {
+		err := o.client.ClaimIntoOrganizationInventory(plan.OrgID.ValueString(), &meraki.InventoryClaimRequest{Serials: added})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to claim into organization inventory", "Failed to claim into organization inventory: "+err.Error())
+			return
+		}
+	}
+	if len(removed) > 0 {
+		err := o.client.ReleaseFromOrganizationInventory(plan.OrgID.ValueString(), removed)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to release from organization inventory", "Failed to release from organization inventory: "+err.Error())
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the organization inventory claim resource")
+}
+
+func (o *organizationInventoryClaimResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the organization inventory claim resource")
+	var state OrganizationInventoryClaimResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serials []string
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, state.Serials, &serials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(serials) == 0 {
+		return
+	}
+
+	err := o.client.ReleaseFromOrganizationInventory(state.OrgID.ValueString(), serials)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to release from organization inventory", "Failed to release from organization inventory: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the organization inventory claim resource")
+}
+
+// inventoryClaimID returns the ID of a claim made into an organization, so two claims
+// into the same organization do not share an ID. The ID is only computed on creation
+// and kept when the serials change.
+func inventoryClaimID(orgID string, claim *meraki.InventoryClaimRequest) string {
+	orders := append([]string{}, claim.Orders...)
+	serials := append([]string{}, claim.Serials...)
+	licenses := make([]string, 0, len(claim.Licenses))
+	for _, license := range claim.Licenses {
+		licenses = append(licenses, license.Key)
+	}
+	sort.Strings(orders)
+	sort.Strings(serials)
+	sort.Strings(licenses)
+
+	content := strings.Join(orders, ",") + "|" + strings.Join(serials, ",") + "|" + strings.Join(licenses, ",")
+	sum := sha256.Sum256([]byte(content))
+	return orgID + "/" + hex.EncodeToString(sum[:8])
+}
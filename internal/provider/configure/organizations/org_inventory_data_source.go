@@ -0,0 +1,227 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &organizationInventoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationInventoryDataSource{}
+)
+
+func NewOrganizationInventoryDataSource() datasource.DataSource {
+	return &organizationInventoryDataSource{}
+}
+
+type organizationInventoryDataSource struct {
+	client meraki.Client
+}
+
+type organizationInventoryDataSourceModel struct {
+	OrgID          types.String                     `tfsdk:"org_id"`
+	Models         []types.String                   `tfsdk:"models"`
+	ProductTypes   []types.String                   `tfsdk:"product_types"`
+	UsedState      types.String                     `tfsdk:"used_state"`
+	Tags           []types.String                   `tfsdk:"tags"`
+	TagsFilterType types.String                     `tfsdk:"tags_filter_type"`
+	Devices        []organizationInventoryItemModel `tfsdk:"devices"`
+}
+
+type organizationInventoryItemModel struct {
+	Serial                types.String   `tfsdk:"serial"`
+	Mac                   types.String   `tfsdk:"mac"`
+	Name                  types.String   `tfsdk:"name"`
+	Model                 types.String   `tfsdk:"model"`
+	ProductType           types.String   `tfsdk:"product_type"`
+	NetworkID             types.String   `tfsdk:"network_id"`
+	OrderNumber           types.String   `tfsdk:"order_number"`
+	ClaimedAt             types.String   `tfsdk:"claimed_at"`
+	LicenseExpirationDate types.String   `tfsdk:"license_expiration_date"`
+	CountryCode           types.String   `tfsdk:"country_code"`
+	Tags                  []types.String `tfsdk:"tags"`
+}
+
+func (o *organizationInventoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_inventory"
+}
+
+func (o *organizationInventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the organization inventory data source")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	o.client = client
+	tflog.Info(ctx, "Configured the organization inventory data source")
+}
+
+func (o *organizationInventoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the organization whose inventory is read",
+			},
+			"models": schema.ListAttribute{
+				Optional:    true,
+				Description: "Only return devices of these models",
+				ElementType: types.StringType,
+			},
+			"product_types": schema.ListAttribute{
+				Optional:    true,
+				Description: "Only return devices of these product types, e.g. 'appliance', 'switch' or 'wireless'",
+				ElementType: types.StringType,
+			},
+			"used_state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return devices that are 'used' (assigned to a network) or 'unused'",
+				Validators: []validator.String{
+					stringvalidator.OneOf("used", "unused"),
+				},
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				Description: "Only return devices with these tags",
+				ElementType: types.StringType,
+			},
+			"tags_filter_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "How the tags filter is applied, can be 'withAnyTags' or 'withAllTags'. Defaults to 'withAnyTags'",
+				Validators: []validator.String{
+					stringvalidator.OneOf("withAnyTags", "withAllTags"),
+				},
+			},
+			"devices": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The devices in the organization inventory matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"serial": schema.StringAttribute{
+							Computed:    true,
+							Description: "The serial number of the device",
+						},
+						"mac": schema.StringAttribute{
+							Computed:    true,
+							Description: "The MAC address of the device",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the device",
+						},
+						"model": schema.StringAttribute{
+							Computed:    true,
+							Description: "The model of the device",
+						},
+						"product_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The product type of the device",
+						},
+						"network_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the network the device is assigned to, empty if it is unused",
+						},
+						"order_number": schema.StringAttribute{
+							Computed:    true,
+							Description: "The order number the device was claimed with",
+						},
+						"claimed_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the device was claimed into the organization",
+						},
+						"license_expiration_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the license of the device expires",
+						},
+						"country_code": schema.StringAttribute{
+							Computed:    true,
+							Description: "The country code of the device",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							Description: "The tags of the device",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (o *organizationInventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading the organization inventory data source")
+	var state organizationInventoryDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := &meraki.InventoryDevicesFilter{
+		UsedState:      state.UsedState.ValueString(),
+		TagsFilterType: state.TagsFilterType.ValueString(),
+	}
+	for _, model := range state.Models {
+		filter.Models = append(filter.Models, model.ValueString())
+	}
+	for _, pt := range state.ProductTypes {
+		filter.ProductTypes = append(filter.ProductTypes, pt.ValueString())
+	}
+	for _, tag := range state.Tags {
+		filter.Tags = append(filter.Tags, tag.ValueString())
+	}
+
+	devices, err := o.client.GetOrganizationInventoryDevices(state.OrgID.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get organization inventory",
+			"failed to get organization inventory: "+err.Error(),
+		)
+		return
+	}
+
+	state.Devices = make([]organizationInventoryItemModel, 0, len(devices))
+	for _, device := range devices {
+		item := organizationInventoryItemModel{
+			Serial:                types.StringValue(device.Serial),
+			Mac:                   types.StringValue(device.Mac),
+			Name:                  types.StringValue(device.Name),
+			Model:                 types.StringValue(device.Model),
+			ProductType:           types.StringValue(device.ProductType),
+			NetworkID:             types.StringValue(device.NetworkID),
+			OrderNumber:           types.StringValue(device.OrderNumber),
+			ClaimedAt:             types.StringValue(device.ClaimedAt),
+			LicenseExpirationDate: types.StringValue(device.LicenseExpirationDate),
+			CountryCode:           types.StringValue(device.CountryCode),
+			Tags:                  make([]types.String, 0, len(device.Tags)),
+		}
+		for _, tag := range device.Tags {
+			item.Tags = append(item.Tags, types.StringValue(tag))
+		}
+		state.Devices = append(state.Devices, item)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the organization inventory data source")
+}
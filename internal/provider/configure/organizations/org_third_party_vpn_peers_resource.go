@@ -0,0 +1,489 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &organizationThirdPartyVPNPeersResource{}
+	_ resource.ResourceWithConfigure      = &organizationThirdPartyVPNPeersResource{}
+	_ resource.ResourceWithImportState    = &organizationThirdPartyVPNPeersResource{}
+	_ resource.ResourceWithValidateConfig = &organizationThirdPartyVPNPeersResource{}
+)
+
+func NewOrganizationThirdPartyVPNPeersResource() resource.Resource {
+	return &organizationThirdPartyVPNPeersResource{}
+}
+
+type organizationThirdPartyVPNPeersResource struct {
+	client meraki.Client
+}
+
+type OrganizationThirdPartyVPNPeersResourceModel struct {
+	ID    types.String                         `tfsdk:"id"`
+	OrgID types.String                         `tfsdk:"org_id"`
+	Peers []OrganizationThirdPartyVPNPeerModel `tfsdk:"peers"`
+}
+
+type OrganizationThirdPartyVPNPeerModel struct {
+	Name                types.String                                     `tfsdk:"name"`
+	PublicIP            types.String                                     `tfsdk:"public_ip"`
+	PublicHostname      types.String                                     `tfsdk:"public_hostname"`
+	PrivateSubnets      []types.String                                   `tfsdk:"private_subnets"`
+	LocalID             types.String                                     `tfsdk:"local_id"`
+	RemoteID            types.String                                     `tfsdk:"remote_id"`
+	Secret              types.String                                     `tfsdk:"secret"`
+	IkeVersion          types.String                                     `tfsdk:"ike_version"`
+	NetworkTags         []types.String                                   `tfsdk:"network_tags"`
+	IpsecPoliciesPreset types.String                                     `tfsdk:"ipsec_policies_preset"`
+	IpsecPolicies       *OrganizationThirdPartyVPNPeerIPsecPoliciesModel `tfsdk:"ipsec_policies"`
+}
+
+type OrganizationThirdPartyVPNPeerIPsecPoliciesModel struct {
+	IkeCipherAlgo         []types.String `tfsdk:"ike_cipher_algo"`
+	IkeAuthAlgo           []types.String `tfsdk:"ike_auth_algo"`
+	IkePrfAlgo            []types.String `tfsdk:"ike_prf_algo"`
+	IkeDiffieHellmanGroup []types.String `tfsdk:"ike_diffie_hellman_group"`
+	IkeLifetime           types.Int64    `tfsdk:"ike_lifetime"`
+	ChildCipherAlgo       []types.String `tfsdk:"child_cipher_algo"`
+	ChildAuthAlgo         []types.String `tfsdk:"child_auth_algo"`
+	ChildPfsGroup         []types.String `tfsdk:"child_pfs_group"`
+	ChildLifetime         types.Int64    `tfsdk:"child_lifetime"`
+}
+
+func (o *organizationThirdPartyVPNPeersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_third_party_vpn_peers"
+}
+
+// algorithmList returns the schema of a required list of IPsec algorithms limited to the given values.
+func algorithmList(description string, values ...string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Required:    true,
+		Description: fmt.Sprintf("%s, any of '%s'", description, strings.Join(values, "', '")),
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(values...)),
+		},
+	}
+}
+
+func (o *organizationThirdPartyVPNPeersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	prfAlgo := algorithmList("The IKE pseudo-random function algorithms, IKEv2 only", "prfsha256", "prfsha1", "prfmd5", "default")
+	prfAlgo.Required = false
+	prfAlgo.Optional = true
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete list of third-party (non-Meraki) IPsec VPN peers of an organization. " +
+			"The API never returns the pre-shared secrets in clear text, so changes to a secret made outside of Terraform are not detected. " +
+			"All peers are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the organization ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peers": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The third-party VPN peers",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the peer, must be unique in the organization",
+						},
+						"public_ip": schema.StringAttribute{
+							Optional:    true,
+							Description: "The public IP of the peer. Exactly one of public_ip and public_hostname must be set",
+							Validators: []validator.String{
+								validators.IPv4Address(),
+							},
+						},
+						"public_hostname": schema.StringAttribute{
+							Optional:    true,
+							Description: "The public hostname of the peer. Exactly one of public_ip and public_hostname must be set",
+						},
+						"private_subnets": schema.ListAttribute{
+							Required:    true,
+							Description: "The remote subnets reachable through the peer",
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(validators.IPv4CIDR()),
+							},
+						},
+						"local_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The local ID sent to the peer during IKE negotiation",
+						},
+						"remote_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The remote ID expected from the peer during IKE negotiation",
+						},
+						"secret": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "The pre-shared secret of the tunnel",
+						},
+						"ike_version": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The IKE version, can be '1' or '2'. Defaults to '1'",
+							Default:     stringdefault.StaticString("1"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("1", "2"),
+							},
+						},
+						"network_tags": schema.ListAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The network tags of the MX networks that connect to the peer. Defaults to ['all']",
+							ElementType: types.StringType,
+							Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+								types.StringValue("all"),
+							})),
+						},
+						"ipsec_policies_preset": schema.StringAttribute{
+							Optional:    true,
+							Description: "The IPsec policy preset, can be 'default', 'aws', 'azure', 'umbrella' or 'zscaler'. Conflicts with ipsec_policies",
+							Validators: []validator.String{
+								stringvalidator.OneOf("default", "aws", "azure", "umbrella", "zscaler"),
+							},
+						},
+						"ipsec_policies": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Custom IPsec policies. Conflicts with ipsec_policies_preset. When neither is set the 'default' preset is used",
+							Attributes: map[string]schema.Attribute{
+								"ike_cipher_algo":          algorithmList("The IKE (phase 1) encryption algorithms", "aes256", "aes192", "aes128", "tripledes", "des"),
+								"ike_auth_algo":            algorithmList("The IKE (phase 1) authentication algorithms", "sha256", "sha1", "md5"),
+								"ike_prf_algo":             prfAlgo,
+								"ike_diffie_hellman_group": algorithmList("The IKE (phase 1) Diffie-Hellman groups", "group14", "group5", "group2", "group1"),
+								"ike_lifetime": schema.Int64Attribute{
+									Optional:    true,
+									Computed:    true,
+									Description: "The IKE (phase 1) lifetime in seconds. Defaults to 28800",
+									Default:     int64default.StaticInt64(28800),
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								"child_cipher_algo": algorithmList("The child (phase 2) encryption algorithms", "aes256", "aes192", "aes128", "tripledes", "des", "null"),
+								"child_auth_algo":   algorithmList("The child (phase 2) authentication algorithms", "sha256", "sha1", "md5"),
+								"child_pfs_group":   algorithmList("The child (phase 2) perfect forward secrecy groups", "disabled", "group14", "group5", "group2", "group1"),
+								"child_lifetime": schema.Int64Attribute{
+									Optional:    true,
+									Computed:    true,
+									Description: "The child (phase 2) lifetime in seconds. Defaults to 28800",
+									Default:     int64default.StaticInt64(28800),
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (o *organizationThirdPartyVPNPeersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the organization third-party VPN peers resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	o.client = client
+	tflog.Info(ctx, "Configured the organization third-party VPN peers resource")
+}
+
+func (o *organizationThirdPartyVPNPeersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OrganizationThirdPartyVPNPeersResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make(map[string]bool)
+	for i, peer := range config.Peers {
+		peerPath := path.Root("peers").AtListIndex(i)
+
+		if !peer.Name.IsUnknown() {
+			if names[peer.Name.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					peerPath.AtName("name"),
+					"Invalid third-party VPN peer",
+					fmt.Sprintf("peer name %q is used more than once", peer.Name.ValueString()),
+				)
+			}
+			names[peer.Name.ValueString()] = true
+		}
+
+		if !peer.PublicIP.IsUnknown() && !peer.PublicHostname.IsUnknown() && peer.PublicIP.IsNull() == peer.PublicHostname.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				peerPath,
+				"Invalid third-party VPN peer",
+				"exactly one of public_ip and public_hostname must be set",
+			)
+		}
+
+		if !peer.IpsecPoliciesPreset.IsNull() && peer.IpsecPolicies != nil {
+			resp.Diagnostics.AddAttributeError(
+				peerPath.AtName("ipsec_policies"),
+				"Invalid third-party VPN peer",
+				"ipsec_policies can not be set together with ipsec_policies_preset",
+			)
+		}
+
+		if peer.IpsecPolicies != nil && len(peer.IpsecPolicies.IkePrfAlgo) > 0 && peer.IkeVersion.ValueString() != "2" && !peer.IkeVersion.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				peerPath.AtName("ipsec_policies").AtName("ike_prf_algo"),
+				"Invalid third-party VPN peer",
+				"ike_prf_algo can only be set when ike_version is '2'",
+			)
+		}
+	}
+}
+
+func (o *organizationThirdPartyVPNPeersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the organization third-party VPN peers resource")
+	var plan OrganizationThirdPartyVPNPeersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peers, err := o.client.UpdateApplianceThirdPartyVPNPeers(plan.OrgID.ValueString(), thirdPartyVPNPeersToAPI(plan.Peers))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update third-party VPN peers", "Failed to update third-party VPN peers: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.OrgID
+	plan.Peers = thirdPartyVPNPeersFromAPI(plan.Peers, peers.Peers)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the organization third-party VPN peers resource")
+}
+
+func (o *organizationThirdPartyVPNPeersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the organization third-party VPN peers resource")
+	var state OrganizationThirdPartyVPNPeersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peers, err := o.client.GetApplianceThirdPartyVPNPeers(state.OrgID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get third-party VPN peers", "Failed to get third-party VPN peers: "+err.Error())
+		return
+	}
+
+	state.Peers = thirdPartyVPNPeersFromAPI(state.Peers, peers.Peers)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the organization third-party VPN peers resource")
+}
+
+func (o *organizationThirdPartyVPNPeersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the organization third-party VPN peers resource")
+	var plan OrganizationThirdPartyVPNPeersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peers, err := o.client.UpdateApplianceThirdPartyVPNPeers(plan.OrgID.ValueString(), thirdPartyVPNPeersToAPI(plan.Peers))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update third-party VPN peers", "Failed to update third-party VPN peers: "+err.Error())
+		return
+	}
+
+	plan.Peers = thirdPartyVPNPeersFromAPI(plan.Peers, peers.Peers)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the organization third-party VPN peers resource")
+}
+
+func (o *organizationThirdPartyVPNPeersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the organization third-party VPN peers resource")
+	var state OrganizationThirdPartyVPNPeersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := o.client.UpdateApplianceThirdPartyVPNPeers(state.OrgID.ValueString(), &meraki.ApplianceThirdPartyVPNPeers{
+		Peers: []meraki.ApplianceThirdPartyVPNPeer{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete third-party VPN peers", "Failed to delete third-party VPN peers: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the organization third-party VPN peers resource")
+}
+
+func (o *organizationThirdPartyVPNPeersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), req.ID)...)
+}
+
+func thirdPartyVPNPeersToAPI(peers []OrganizationThirdPartyVPNPeerModel) *meraki.ApplianceThirdPartyVPNPeers {
+	result := &meraki.ApplianceThirdPartyVPNPeers{
+		Peers: make([]meraki.ApplianceThirdPartyVPNPeer, 0, len(peers)),
+	}
+	for _, peer := range peers {
+		p := meraki.ApplianceThirdPartyVPNPeer{
+			Name:                peer.Name.ValueString(),
+			PublicIP:            peer.PublicIP.ValueString(),
+			PublicHostname:      peer.PublicHostname.ValueString(),
+			PrivateSubnets:      helpers.FromStringValues(peer.PrivateSubnets),
+			LocalID:             peer.LocalID.ValueString(),
+			RemoteID:            peer.RemoteID.ValueString(),
+			Secret:              peer.Secret.ValueString(),
+			IkeVersion:          peer.IkeVersion.ValueString(),
+			NetworkTags:         helpers.FromStringValues(peer.NetworkTags),
+			IpsecPoliciesPreset: peer.IpsecPoliciesPreset.ValueString(),
+		}
+		if policies := peer.IpsecPolicies; policies != nil {
+			p.IpsecPolicies = &meraki.ApplianceThirdPartyVPNIPsecPolicies{
+				IkeCipherAlgo:         helpers.FromStringValues(policies.IkeCipherAlgo),
+				IkeAuthAlgo:           helpers.FromStringValues(policies.IkeAuthAlgo),
+				IkePrfAlgo:            helpers.FromStringValues(policies.IkePrfAlgo),
+				IkeDiffieHellmanGroup: helpers.FromStringValues(policies.IkeDiffieHellmanGroup),
+				IkeLifetime:           policies.IkeLifetime.ValueInt64(),
+				ChildCipherAlgo:       helpers.FromStringValues(policies.ChildCipherAlgo),
+				ChildAuthAlgo:         helpers.FromStringValues(policies.ChildAuthAlgo),
+				ChildPfsGroup:         helpers.FromStringValues(policies.ChildPfsGroup),
+				ChildLifetime:         policies.ChildLifetime.ValueInt64(),
+			}
+		} else if p.IpsecPoliciesPreset == "" {
+			p.IpsecPoliciesPreset = "default"
+		}
+		result.Peers = append(result.Peers, p)
+	}
+	return result
+}
+
+// thirdPartyVPNPeersFromAPI converts the peers returned by the API. The API masks the secrets,
+// so the secret of a peer is taken from the prior peer with the same name. The API also returns
+// both the preset and the resolved policies, only the one that was configured is kept.
+func thirdPartyVPNPeersFromAPI(prior []OrganizationThirdPartyVPNPeerModel, peers []meraki.ApplianceThirdPartyVPNPeer) []OrganizationThirdPartyVPNPeerModel {
+	priorByName := make(map[string]OrganizationThirdPartyVPNPeerModel)
+	for _, peer := range prior {
+		priorByName[peer.Name.ValueString()] = peer
+	}
+
+	result := make([]OrganizationThirdPartyVPNPeerModel, 0, len(peers))
+	for _, peer := range peers {
+		previous, known := priorByName[peer.Name]
+
+		model := OrganizationThirdPartyVPNPeerModel{
+			Name:           types.StringValue(peer.Name),
+			PublicIP:       helpers.StringValueOrNull(peer.PublicIP),
+			PublicHostname: helpers.StringValueOrNull(peer.PublicHostname),
+			PrivateSubnets: helpers.ToStringValues(peer.PrivateSubnets),
+			LocalID:        helpers.StringValueOrNull(peer.LocalID),
+			RemoteID:       helpers.StringValueOrNull(peer.RemoteID),
+			Secret:         types.StringValue(peer.Secret),
+			IkeVersion:     types.StringValue(peer.IkeVersion),
+			NetworkTags:    helpers.ToStringValues(peer.NetworkTags),
+		}
+		if known {
+			model.Secret = previous.Secret
+		}
+		if model.IkeVersion.ValueString() == "" {
+			model.IkeVersion = types.StringValue("1")
+		}
+
+		custom := peer.IpsecPoliciesPreset == "" || peer.IpsecPoliciesPreset == "custom"
+		switch {
+		case known && previous.IpsecPolicies != nil, !known && custom:
+			model.IpsecPoliciesPreset = types.StringNull()
+			model.IpsecPolicies = ipsecPoliciesFromAPI(previous.IpsecPolicies, peer.IpsecPolicies)
+		case known && previous.IpsecPoliciesPreset.IsNull() && peer.IpsecPoliciesPreset == "default":
+			// the default preset is used when neither is configured
+			model.IpsecPoliciesPreset = types.StringNull()
+		default:
+			model.IpsecPoliciesPreset = helpers.StringValueOrNull(peer.IpsecPoliciesPreset)
+		}
+
+		result = append(result, model)
+	}
+	return result
+}
+
+func ipsecPoliciesFromAPI(prior *OrganizationThirdPartyVPNPeerIPsecPoliciesModel, policies *meraki.ApplianceThirdPartyVPNIPsecPolicies) *OrganizationThirdPartyVPNPeerIPsecPoliciesModel {
+	if policies == nil {
+		return nil
+	}
+	model := &OrganizationThirdPartyVPNPeerIPsecPoliciesModel{
+		IkeCipherAlgo:         helpers.ToStringValues(policies.IkeCipherAlgo),
+		IkeAuthAlgo:           helpers.ToStringValues(policies.IkeAuthAlgo),
+		IkePrfAlgo:            helpers.NilIfEmpty(helpers.ToStringValues(policies.IkePrfAlgo)),
+		IkeDiffieHellmanGroup: helpers.ToStringValues(policies.IkeDiffieHellmanGroup),
+		IkeLifetime:           types.Int64Value(policies.IkeLifetime),
+		ChildCipherAlgo:       helpers.ToStringValues(policies.ChildCipherAlgo),
+		ChildAuthAlgo:         helpers.ToStringValues(policies.ChildAuthAlgo),
+		ChildPfsGroup:         helpers.ToStringValues(policies.ChildPfsGroup),
+		ChildLifetime:         types.Int64Value(policies.ChildLifetime),
+	}
+	// the API reports the 'default' PRF for IKEv1 peers even if none was set
+	if prior != nil && prior.IkePrfAlgo == nil {
+		model.IkePrfAlgo = nil
+	}
+	return model
+}
@@ -0,0 +1,80 @@
+package switches
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+)
+
+// validateRoutingInterface checks that the interface IP and the default gateway of a routing
+// interface are usable addresses within its subnet.
+func validateRoutingInterface(subnetValue, interfaceIP, defaultGateway types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if subnetValue.IsUnknown() || subnetValue.IsNull() {
+		return diags
+	}
+	ip, subnet, err := net.ParseCIDR(subnetValue.ValueString())
+	if err != nil {
+		// reported by the attribute validators
+		return diags
+	}
+	if !ip.Equal(subnet.IP) {
+		diags.AddAttributeError(
+			path.Root("subnet"),
+			"Invalid routing interface subnet",
+			fmt.Sprintf("subnet %s has host bits set, did you mean %s?", subnetValue.ValueString(), subnet),
+		)
+		return diags
+	}
+
+	for name, value := range map[string]types.String{"interface_ip": interfaceIP, "default_gateway": defaultGateway} {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if address := net.ParseIP(value.ValueString()); address != nil && !subnet.Contains(address) {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid routing interface address",
+				fmt.Sprintf("%s %s is not within subnet %s", name, address, subnet),
+			)
+		}
+	}
+	return diags
+}
+
+// validateStaticRoute checks that the destination subnet of a static route has no host bits set
+// and does not contain its own next hop.
+func validateStaticRoute(subnetValue, nextHopIP types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if subnetValue.IsUnknown() || subnetValue.IsNull() {
+		return diags
+	}
+	ip, subnet, err := net.ParseCIDR(subnetValue.ValueString())
+	if err != nil {
+		// reported by the attribute validators
+		return diags
+	}
+	if !ip.Equal(subnet.IP) {
+		diags.AddAttributeError(
+			path.Root("subnet"),
+			"Invalid static route subnet",
+			fmt.Sprintf("subnet %s has host bits set, did you mean %s?", subnetValue.ValueString(), subnet),
+		)
+		return diags
+	}
+
+	if !nextHopIP.IsUnknown() && !nextHopIP.IsNull() {
+		if nextHop := net.ParseIP(nextHopIP.ValueString()); nextHop != nil && subnet.Contains(nextHop) {
+			diags.AddAttributeError(
+				path.Root("next_hop_ip"),
+				"Invalid static route next hop",
+				fmt.Sprintf("next_hop_ip %s must not be within the destination subnet %s", nextHop, subnet),
+			)
+		}
+	}
+	return diags
+}
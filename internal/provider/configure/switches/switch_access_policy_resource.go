@@ -0,0 +1,428 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+)
+
+var (
+	_ resource.Resource                   = &switchAccessPolicyResource{}
+	_ resource.ResourceWithConfigure      = &switchAccessPolicyResource{}
+	_ resource.ResourceWithImportState    = &switchAccessPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &switchAccessPolicyResource{}
+)
+
+func NewSwitchAccessPolicyResource() resource.Resource {
+	return &switchAccessPolicyResource{}
+}
+
+type switchAccessPolicyResource struct {
+	client meraki.Client
+}
+
+type SwitchAccessPolicyResourceModel struct {
+	ID                             types.String        `tfsdk:"id"`
+	NetworkID                      types.String        `tfsdk:"network_id"`
+	AccessPolicyNumber             types.Int64         `tfsdk:"access_policy_number"`
+	Name                           types.String        `tfsdk:"name"`
+	RadiusServers                  []RadiusServerModel `tfsdk:"radius_servers"`
+	RadiusTestingEnabled           types.Bool          `tfsdk:"radius_testing_enabled"`
+	RadiusCoaSupportEnabled        types.Bool          `tfsdk:"radius_coa_support_enabled"`
+	RadiusAccountingEnabled        types.Bool          `tfsdk:"radius_accounting_enabled"`
+	RadiusAccountingServers        []RadiusServerModel `tfsdk:"radius_accounting_servers"`
+	HostMode                       types.String        `tfsdk:"host_mode"`
+	AccessPolicyType               types.String        `tfsdk:"access_policy_type"`
+	GuestVlanID                    types.Int64         `tfsdk:"guest_vlan_id"`
+	URLRedirectWalledGardenEnabled types.Bool          `tfsdk:"url_redirect_walled_garden_enabled"`
+	URLRedirectWalledGardenRanges  []types.String      `tfsdk:"url_redirect_walled_garden_ranges"`
+}
+
+type RadiusServerModel struct {
+	Host   types.String `tfsdk:"host"`
+	Port   types.Int64  `tfsdk:"port"`
+	Secret types.String `tfsdk:"secret"`
+}
+
+func (s *switchAccessPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_access_policy"
+}
+
+func radiusServersAttribute(description string, defaultPort int64) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:    true,
+		Description: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					Required:    true,
+					Description: "The IP address of the RADIUS server",
+				},
+				"port": schema.Int64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Description: fmt.Sprintf("The UDP port of the RADIUS server. Defaults to %d", defaultPort),
+					Default:     int64default.StaticInt64(defaultPort),
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"secret": schema.StringAttribute{
+					Required:    true,
+					Sensitive:   true,
+					Description: "The shared secret of the RADIUS server",
+				},
+			},
+		},
+	}
+}
+
+func (s *switchAccessPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	radiusServers := radiusServersAttribute("The RADIUS authentication servers", 1812)
+	radiusServers.Optional = false
+	radiusServers.Required = true
+	radiusServers.Validators = []validator.List{
+		listvalidator.SizeAtLeast(1),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an 802.1X and MAC authentication bypass access policy of the switches of a network, which switch ports use through a 'Custom access policy'.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/access_policy_number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_policy_number": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of the access policy, referenced by the access_policy_number of switch ports",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the access policy",
+			},
+			"radius_servers": radiusServers,
+			"radius_testing_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the RADIUS servers are periodically tested for reachability. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"radius_coa_support_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the RADIUS servers can send change of authorization requests. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"radius_accounting_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether RADIUS accounting is enabled. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"radius_accounting_servers": radiusServersAttribute("The RADIUS accounting servers, required when radius_accounting_enabled is true", 1813),
+			"host_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How many clients are authenticated on a port, can be 'Single-Host', 'Multi-Domain', 'Multi-Host' or 'Multi-Auth'. Defaults to 'Single-Host'",
+				Default:     stringdefault.StaticString("Single-Host"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Single-Host", "Multi-Domain", "Multi-Host", "Multi-Auth"),
+				},
+			},
+			"access_policy_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How clients are authenticated, can be '802.1x', 'MAC authentication bypass' or 'Hybrid authentication'. Defaults to '802.1x'",
+				Default:     stringdefault.StaticString("802.1x"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("802.1x", "MAC authentication bypass", "Hybrid authentication"),
+				},
+			},
+			"guest_vlan_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The VLAN of clients that fail to authenticate or do not support authentication",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"url_redirect_walled_garden_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether clients that are not yet authenticated can reach the url_redirect_walled_garden_ranges. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"url_redirect_walled_garden_ranges": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The networks clients that are not yet authenticated can reach, in CIDR notation",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.CIDR()),
+				},
+			},
+		},
+	}
+}
+
+func (s *switchAccessPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch access policy resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch access policy resource")
+}
+
+func (s *switchAccessPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RadiusAccountingEnabled.IsUnknown() {
+		accountingEnabled := config.RadiusAccountingEnabled.ValueBool()
+		if accountingEnabled && len(config.RadiusAccountingServers) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("radius_accounting_servers"),
+				"Invalid switch access policy",
+				"radius_accounting_servers is required when radius_accounting_enabled is true",
+			)
+		}
+		if !accountingEnabled && len(config.RadiusAccountingServers) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("radius_accounting_servers"),
+				"Invalid switch access policy",
+				"radius_accounting_servers can only be set when radius_accounting_enabled is true",
+			)
+		}
+	}
+
+	if !config.URLRedirectWalledGardenEnabled.IsUnknown() && !config.URLRedirectWalledGardenEnabled.ValueBool() && len(config.URLRedirectWalledGardenRanges) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url_redirect_walled_garden_ranges"),
+			"Invalid switch access policy",
+			"url_redirect_walled_garden_ranges can only be set when url_redirect_walled_garden_enabled is true",
+		)
+	}
+}
+
+func (s *switchAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch access policy resource")
+	var plan SwitchAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := s.client.CreateSwitchAccessPolicy(plan.NetworkID.ValueString(), accessPolicyToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create switch access policy", "Failed to create switch access policy: "+err.Error())
+		return
+	}
+
+	number, err := strconv.ParseInt(string(policy.AccessPolicyNumber), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create switch access policy", fmt.Sprintf("Failed to create switch access policy: unexpected access policy number %q", policy.AccessPolicyNumber))
+		return
+	}
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + string(policy.AccessPolicyNumber))
+	plan.AccessPolicyNumber = types.Int64Value(number)
+	accessPolicyFromAPI(policy, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch access policy resource")
+}
+
+func (s *switchAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch access policy resource")
+	var state SwitchAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := s.client.GetSwitchAccessPolicy(state.NetworkID.ValueString(), strconv.FormatInt(state.AccessPolicyNumber.ValueInt64(), 10))
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch access policy", "Failed to get switch access policy: "+err.Error())
+		return
+	}
+
+	accessPolicyFromAPI(policy, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch access policy resource")
+}
+
+func (s *switchAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch access policy resource")
+	var plan SwitchAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := s.client.UpdateSwitchAccessPolicy(plan.NetworkID.ValueString(), strconv.FormatInt(plan.AccessPolicyNumber.ValueInt64(), 10), accessPolicyToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch access policy", "Failed to update switch access policy: "+err.Error())
+		return
+	}
+
+	accessPolicyFromAPI(policy, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch access policy resource")
+}
+
+func (s *switchAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch access policy resource")
+	var state SwitchAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.DeleteSwitchAccessPolicy(state.NetworkID.ValueString(), strconv.FormatInt(state.AccessPolicyNumber.ValueInt64(), 10))
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch access policy", "Failed to delete switch access policy: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch access policy resource")
+}
+
+func (s *switchAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, accessPolicyNumber, err := helpers.SplitImportID(req.ID, "network_id/access_policy_number")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	number, err := strconv.ParseInt(accessPolicyNumber, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("access_policy_number must be a number, got: %s", accessPolicyNumber))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access_policy_number"), number)...)
+}
+
+func accessPolicyToAPI(plan *SwitchAccessPolicyResourceModel) *meraki.SwitchAccessPolicy {
+	return &meraki.SwitchAccessPolicy{
+		Name:                           plan.Name.ValueString(),
+		RadiusServers:                  radiusServersToAPI(plan.RadiusServers),
+		RadiusTestingEnabled:           plan.RadiusTestingEnabled.ValueBool(),
+		RadiusCoaSupportEnabled:        plan.RadiusCoaSupportEnabled.ValueBool(),
+		RadiusAccountingEnabled:        plan.RadiusAccountingEnabled.ValueBool(),
+		RadiusAccountingServers:        radiusServersToAPI(plan.RadiusAccountingServers),
+		HostMode:                       plan.HostMode.ValueString(),
+		AccessPolicyType:               plan.AccessPolicyType.ValueString(),
+		GuestVlanID:                    helpers.Int64PointerOrNil(plan.GuestVlanID),
+		URLRedirectWalledGardenEnabled: plan.URLRedirectWalledGardenEnabled.ValueBool(),
+		URLRedirectWalledGardenRanges:  helpers.FromStringValues(plan.URLRedirectWalledGardenRanges),
+	}
+}
+
+func radiusServersToAPI(servers []RadiusServerModel) []meraki.SwitchAccessPolicyRadiusServer {
+	var result []meraki.SwitchAccessPolicyRadiusServer
+	for _, server := range servers {
+		result = append(result, meraki.SwitchAccessPolicyRadiusServer{
+			Host:   server.Host.ValueString(),
+			Port:   server.Port.ValueInt64(),
+			Secret: server.Secret.ValueString(),
+		})
+	}
+	return result
+}
+
+func accessPolicyFromAPI(policy *meraki.SwitchAccessPolicy, state *SwitchAccessPolicyResourceModel) {
+	state.Name = types.StringValue(policy.Name)
+	state.RadiusServers = radiusServersFromAPI(state.RadiusServers, policy.RadiusServers)
+	state.RadiusTestingEnabled = types.BoolValue(policy.RadiusTestingEnabled)
+	state.RadiusCoaSupportEnabled = types.BoolValue(policy.RadiusCoaSupportEnabled)
+	state.RadiusAccountingEnabled = types.BoolValue(policy.RadiusAccountingEnabled)
+	// the dashboard keeps the accounting servers after accounting is disabled, only track the ones in use
+	var radiusAccountingServers []RadiusServerModel
+	if policy.RadiusAccountingEnabled {
+		radiusAccountingServers = radiusServersFromAPI(state.RadiusAccountingServers, policy.RadiusAccountingServers)
+	}
+	state.RadiusAccountingServers = radiusAccountingServers
+	state.HostMode = types.StringValue(policy.HostMode)
+	state.AccessPolicyType = types.StringValue(policy.AccessPolicyType)
+	state.GuestVlanID = helpers.Int64ValueOrNull(policy.GuestVlanID)
+	state.URLRedirectWalledGardenEnabled = types.BoolValue(policy.URLRedirectWalledGardenEnabled)
+	var walledGardenRanges []types.String
+	if policy.URLRedirectWalledGardenEnabled {
+		walledGardenRanges = helpers.NilIfEmpty(helpers.ToStringValues(policy.URLRedirectWalledGardenRanges))
+	}
+	state.URLRedirectWalledGardenRanges = walledGardenRanges
+}
+
+// radiusServersFromAPI converts the RADIUS servers of an access policy. Their secrets are never
+// returned by the API and are taken from prior.
+func radiusServersFromAPI(prior []RadiusServerModel, servers []meraki.SwitchAccessPolicyRadiusServer) []RadiusServerModel {
+	var result []RadiusServerModel
+	for _, server := range servers {
+		result = append(result, RadiusServerModel{
+			Host: types.StringValue(server.Host),
+			Port: types.Int64Value(server.Port),
+		})
+	}
+	return helpers.KeepSecrets(prior, result,
+		func(server RadiusServerModel) string { return server.Host.ValueString() + ":" + server.Port.String() },
+		func(server *RadiusServerModel) *types.String { return &server.Secret },
+	)
+}
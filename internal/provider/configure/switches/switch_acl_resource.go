@@ -0,0 +1,378 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net"
+	"regexp"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &switchACLResource{}
+	_ resource.ResourceWithConfigure      = &switchACLResource{}
+	_ resource.ResourceWithImportState    = &switchACLResource{}
+	_ resource.ResourceWithValidateConfig = &switchACLResource{}
+)
+
+// matches 'any' or a VLAN ID between 1 and 4095
+var aclVlanRegexp = regexp.MustCompile(`^(?i:any)$|^([1-9]\d{0,2}|[1-3]\d{3}|40[0-8]\d|409[0-5])$`)
+
+func NewSwitchACLResource() resource.Resource {
+	return &switchACLResource{}
+}
+
+type switchACLResource struct {
+	client meraki.Client
+}
+
+type SwitchACLResourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	NetworkID types.String         `tfsdk:"network_id"`
+	Rules     []SwitchACLRuleModel `tfsdk:"rules"`
+}
+
+type SwitchACLRuleModel struct {
+	Comment   types.String `tfsdk:"comment"`
+	Policy    types.String `tfsdk:"policy"`
+	IPVersion types.String `tfsdk:"ip_version"`
+	Protocol  types.String `tfsdk:"protocol"`
+	SrcCidr   types.String `tfsdk:"src_cidr"`
+	SrcPort   types.String `tfsdk:"src_port"`
+	DstCidr   types.String `tfsdk:"dst_cidr"`
+	DstPort   types.String `tfsdk:"dst_port"`
+	Vlan      types.String `tfsdk:"vlan"`
+}
+
+func (s *switchACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_acl"
+}
+
+func (s *switchACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete, ordered list of the access control rules of the switches of a network. All rules are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The access control rules, the implicit default rule allowing all traffic is not part of the list",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "A description of the rule",
+							Default:     stringdefault.StaticString(""),
+						},
+						"policy": schema.StringAttribute{
+							Required:    true,
+							Description: "The action taken by the rule, can be 'allow' or 'deny'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"ip_version": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The IP version matched by the rule, can be 'any', 'ipv4' or 'ipv6'. Defaults to 'any'",
+							Default:     stringdefault.StaticString("any"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("any", "ipv4", "ipv6"),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Required:    true,
+							Description: "The protocol matched by the rule, can be 'tcp', 'udp' or 'any'",
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive("tcp", "udp", "any"),
+							},
+						},
+						"src_cidr": schema.StringAttribute{
+							Required:    true,
+							Description: "The source IP or CIDR, or 'any'",
+							Validators: []validator.String{
+								validators.FirewallCidr(),
+							},
+						},
+						"src_port": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The source port or port range, or 'any'. Only allowed for tcp and udp rules. Defaults to 'any'",
+							Default:     stringdefault.StaticString("any"),
+							Validators: []validator.String{
+								validators.Ports(),
+							},
+						},
+						"dst_cidr": schema.StringAttribute{
+							Required:    true,
+							Description: "The destination IP or CIDR, or 'any'",
+							Validators: []validator.String{
+								validators.FirewallCidr(),
+							},
+						},
+						"dst_port": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The destination port or port range, or 'any'. Only allowed for tcp and udp rules. Defaults to 'any'",
+							Default:     stringdefault.StaticString("any"),
+							Validators: []validator.String{
+								validators.Ports(),
+							},
+						},
+						"vlan": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The VLAN of the incoming traffic, a VLAN ID or 'any'. Defaults to 'any'",
+							Default:     stringdefault.StaticString("any"),
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(aclVlanRegexp, "must be 'any' or a VLAN ID between 1 and 4095"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *switchACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch ACL resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch ACL resource")
+}
+
+func (s *switchACLResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchACLResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		if !rule.Protocol.IsUnknown() && strings.EqualFold(rule.Protocol.ValueString(), "any") {
+			for name, port := range map[string]types.String{"src_port": rule.SrcPort, "dst_port": rule.DstPort} {
+				if !port.IsNull() && !port.IsUnknown() && !strings.EqualFold(port.ValueString(), "any") {
+					resp.Diagnostics.AddAttributeError(
+						rulePath.AtName(name),
+						"Invalid switch ACL rule",
+						fmt.Sprintf("%s can only be set for tcp and udp rules", name),
+					)
+				}
+			}
+		}
+
+		// an unset ip_version defaults to 'any', which allows both address families
+		if rule.IPVersion.IsNull() || rule.IPVersion.IsUnknown() || rule.IPVersion.ValueString() == "any" {
+			continue
+		}
+		ipv6 := rule.IPVersion.ValueString() == "ipv6"
+		for name, cidr := range map[string]types.String{"src_cidr": rule.SrcCidr, "dst_cidr": rule.DstCidr} {
+			if cidr.IsUnknown() || strings.EqualFold(cidr.ValueString(), "any") {
+				continue
+			}
+			if ip := aclAddress(cidr.ValueString()); ip != nil && (ip.To4() == nil) != ipv6 {
+				resp.Diagnostics.AddAttributeError(
+					rulePath.AtName(name),
+					"Invalid switch ACL rule",
+					fmt.Sprintf("%s %s does not match ip_version %s", name, cidr.ValueString(), rule.IPVersion.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (s *switchACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch ACL resource")
+	var plan SwitchACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, err := s.client.UpdateSwitchACL(plan.NetworkID.ValueString(), switchACLToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch ACL", "Failed to update switch ACL: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	plan.Rules = switchACLRulesFromAPI(plan.Rules, acl.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch ACL resource")
+}
+
+func (s *switchACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch ACL resource")
+	var state SwitchACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, err := s.client.GetSwitchACL(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch ACL", "Failed to get switch ACL: "+err.Error())
+		return
+	}
+
+	state.Rules = switchACLRulesFromAPI(state.Rules, acl.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch ACL resource")
+}
+
+func (s *switchACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch ACL resource")
+	var plan SwitchACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acl, err := s.client.UpdateSwitchACL(plan.NetworkID.ValueString(), switchACLToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch ACL", "Failed to update switch ACL: "+err.Error())
+		return
+	}
+
+	plan.Rules = switchACLRulesFromAPI(plan.Rules, acl.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch ACL resource")
+}
+
+func (s *switchACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch ACL resource")
+	var state SwitchACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.UpdateSwitchACL(state.NetworkID.ValueString(), &meraki.SwitchACL{
+		Rules: []meraki.SwitchACLRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch ACL", "Failed to delete switch ACL: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch ACL resource")
+}
+
+func (s *switchACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+// aclAddress returns the IP of an address or CIDR of a rule, or nil if it is neither.
+func aclAddress(value string) net.IP {
+	if ip := net.ParseIP(value); ip != nil {
+		return ip
+	}
+	ip, _, _ := net.ParseCIDR(value)
+	return ip
+}
+
+func switchACLToAPI(rules []SwitchACLRuleModel) *meraki.SwitchACL {
+	result := &meraki.SwitchACL{
+		Rules: make([]meraki.SwitchACLRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		result.Rules = append(result.Rules, meraki.SwitchACLRule{
+			Comment:   rule.Comment.ValueString(),
+			Policy:    rule.Policy.ValueString(),
+			IPVersion: rule.IPVersion.ValueString(),
+			Protocol:  rule.Protocol.ValueString(),
+			SrcCidr:   rule.SrcCidr.ValueString(),
+			SrcPort:   rule.SrcPort.ValueString(),
+			DstCidr:   rule.DstCidr.ValueString(),
+			DstPort:   rule.DstPort.ValueString(),
+			Vlan:      rule.Vlan.ValueString(),
+		})
+	}
+	return result
+}
+
+// switchACLRulesFromAPI converts the rules returned by the API, dropping the implicit default rule
+// the API always appends. Values that only differ in case from the prior rule at the same position
+// are kept as configured.
+func switchACLRulesFromAPI(prior []SwitchACLRuleModel, rules []meraki.SwitchACLRule) []SwitchACLRuleModel {
+	if len(rules) > 0 && rules[len(rules)-1].Comment == "Default rule" {
+		rules = rules[:len(rules)-1]
+	}
+
+	result := make([]SwitchACLRuleModel, 0, len(rules))
+	for i, rule := range rules {
+		var previous SwitchACLRuleModel
+		if i < len(prior) {
+			previous = prior[i]
+		}
+		result = append(result, SwitchACLRuleModel{
+			Comment:   types.StringValue(rule.Comment),
+			Policy:    types.StringValue(rule.Policy),
+			IPVersion: types.StringValue(rule.IPVersion),
+			Protocol:  helpers.KeepCase(previous.Protocol, rule.Protocol),
+			SrcCidr:   helpers.KeepCase(previous.SrcCidr, rule.SrcCidr),
+			SrcPort:   helpers.KeepCase(previous.SrcPort, rule.SrcPort),
+			DstCidr:   helpers.KeepCase(previous.DstCidr, rule.DstCidr),
+			DstPort:   helpers.KeepCase(previous.DstPort, rule.DstPort),
+			Vlan:      helpers.KeepCase(previous.Vlan, rule.Vlan),
+		})
+	}
+	return result
+}
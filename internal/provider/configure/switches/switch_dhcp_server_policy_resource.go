@@ -0,0 +1,429 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &switchDhcpServerPolicyResource{}
+	_ resource.ResourceWithConfigure      = &switchDhcpServerPolicyResource{}
+	_ resource.ResourceWithImportState    = &switchDhcpServerPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &switchDhcpServerPolicyResource{}
+)
+
+func NewSwitchDhcpServerPolicyResource() resource.Resource {
+	return &switchDhcpServerPolicyResource{}
+}
+
+type switchDhcpServerPolicyResource struct {
+	client meraki.Client
+}
+
+type SwitchDhcpServerPolicyResourceModel struct {
+	ID                          types.String                            `tfsdk:"id"`
+	NetworkID                   types.String                            `tfsdk:"network_id"`
+	DefaultPolicy               types.String                            `tfsdk:"default_policy"`
+	AllowedServers              []types.String                          `tfsdk:"allowed_servers"`
+	BlockedServers              []types.String                          `tfsdk:"blocked_servers"`
+	AlertsEmailEnabled          types.Bool                              `tfsdk:"alerts_email_enabled"`
+	ArpInspectionEnabled        types.Bool                              `tfsdk:"arp_inspection_enabled"`
+	ArpInspectionTrustedServers []SwitchArpInspectionTrustedServerModel `tfsdk:"arp_inspection_trusted_servers"`
+}
+
+type SwitchArpInspectionTrustedServerModel struct {
+	Mac         types.String `tfsdk:"mac"`
+	Vlan        types.Int64  `tfsdk:"vlan"`
+	IPv4Address types.String `tfsdk:"ipv4_address"`
+}
+
+func (s *switchDhcpServerPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_dhcp_server_policy"
+}
+
+func (s *switchDhcpServerPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages which DHCP servers the switches of a network allow, and the trusted servers of dynamic ARP inspection. All DHCP servers are allowed and the trusted servers are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether DHCP servers are allowed or blocked unless listed, can be 'allow' or 'block'. Defaults to 'allow'",
+				Default:     stringdefault.StaticString("allow"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("allow", "block"),
+				},
+			},
+			"allowed_servers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The MAC addresses of the DHCP servers allowed when default_policy is 'block'",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.MACAddress()),
+				},
+			},
+			"blocked_servers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The MAC addresses of the DHCP servers blocked when default_policy is 'allow'",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.MACAddress()),
+				},
+			},
+			"alerts_email_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether an email alert is sent when a new DHCP server is seen. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"arp_inspection_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether dynamic ARP inspection is enabled. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"arp_inspection_trusted_servers": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The servers whose ARP packets are not inspected, all other trusted servers of the network are removed",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"mac": schema.StringAttribute{
+							Required:    true,
+							Description: "The MAC address of the server",
+							Validators: []validator.String{
+								validators.MACAddress(),
+							},
+						},
+						"vlan": schema.Int64Attribute{
+							Required:    true,
+							Description: "The VLAN of the server",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4094),
+							},
+						},
+						"ipv4_address": schema.StringAttribute{
+							Required:    true,
+							Description: "The IPv4 address of the server",
+							Validators: []validator.String{
+								validators.IPv4Address(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *switchDhcpServerPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch DHCP server policy resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch DHCP server policy resource")
+}
+
+func (s *switchDhcpServerPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchDhcpServerPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DefaultPolicy.IsUnknown() {
+		return
+	}
+	defaultPolicy := "allow"
+	if !config.DefaultPolicy.IsNull() {
+		defaultPolicy = config.DefaultPolicy.ValueString()
+	}
+
+	if defaultPolicy != "block" && len(config.AllowedServers) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_servers"),
+			"Invalid switch DHCP server policy",
+			"allowed_servers can only be set when default_policy is 'block'",
+		)
+	}
+	if defaultPolicy != "allow" && len(config.BlockedServers) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("blocked_servers"),
+			"Invalid switch DHCP server policy",
+			"blocked_servers can only be set when default_policy is 'allow'",
+		)
+	}
+}
+
+func (s *switchDhcpServerPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch DHCP server policy resource")
+	var plan SwitchDhcpServerPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.NetworkID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch DHCP server policy resource")
+}
+
+func (s *switchDhcpServerPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch DHCP server policy resource")
+	var state SwitchDhcpServerPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := state.NetworkID.ValueString()
+	policy, err := s.client.GetSwitchDhcpServerPolicy(networkID)
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch DHCP server policy", "Failed to get switch DHCP server policy: "+err.Error())
+		return
+	}
+	servers, err := s.client.GetSwitchArpInspectionTrustedServers(networkID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch ARP inspection trusted servers", "Failed to get switch ARP inspection trusted servers: "+err.Error())
+		return
+	}
+
+	dhcpServerPolicyFromAPI(policy, &state)
+	state.ArpInspectionTrustedServers = trustedServersFromAPI(state.ArpInspectionTrustedServers, servers)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch DHCP server policy resource")
+}
+
+func (s *switchDhcpServerPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch DHCP server policy resource")
+	var plan SwitchDhcpServerPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch DHCP server policy resource")
+}
+
+func (s *switchDhcpServerPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch DHCP server policy resource")
+	var state SwitchDhcpServerPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := state.NetworkID.ValueString()
+	_, err := s.client.UpdateSwitchDhcpServerPolicy(networkID, &meraki.SwitchDhcpServerPolicy{
+		DefaultPolicy:  "allow",
+		AllowedServers: []string{},
+		BlockedServers: []string{},
+	})
+	if meraki.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete switch DHCP server policy", "Failed to delete switch DHCP server policy: "+err.Error())
+		return
+	}
+
+	servers, err := s.client.GetSwitchArpInspectionTrustedServers(networkID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch ARP inspection trusted servers", "Failed to get switch ARP inspection trusted servers: "+err.Error())
+		return
+	}
+	for _, server := range servers {
+		err := s.client.DeleteSwitchArpInspectionTrustedServer(networkID, server.TrustedServerID)
+		if err != nil && !meraki.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete switch ARP inspection trusted server", "Failed to delete switch ARP inspection trusted server: "+err.Error())
+			return
+		}
+	}
+	tflog.Info(ctx, "Deleted the switch DHCP server policy resource")
+}
+
+func (s *switchDhcpServerPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+// apply updates the DHCP server policy and makes the trusted servers of the network match the plan.
+func (s *switchDhcpServerPolicyResource) apply(ctx context.Context, plan *SwitchDhcpServerPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	networkID := plan.NetworkID.ValueString()
+
+	policy, err := s.client.UpdateSwitchDhcpServerPolicy(networkID, dhcpServerPolicyToAPI(plan))
+	if err != nil {
+		diags.AddError("Failed to update switch DHCP server policy", "Failed to update switch DHCP server policy: "+err.Error())
+		return diags
+	}
+	dhcpServerPolicyFromAPI(policy, plan)
+
+	existing, err := s.client.GetSwitchArpInspectionTrustedServers(networkID)
+	if err != nil {
+		diags.AddError("Failed to get switch ARP inspection trusted servers", "Failed to get switch ARP inspection trusted servers: "+err.Error())
+		return diags
+	}
+
+	desired := make([]meraki.SwitchArpInspectionTrustedServer, 0, len(plan.ArpInspectionTrustedServers))
+	for _, server := range plan.ArpInspectionTrustedServers {
+		desired = append(desired, trustedServerToAPI(server))
+	}
+	reconciler := helpers.Reconciler[meraki.SwitchArpInspectionTrustedServer]{
+		Name: "switch ARP inspection trusted server",
+		ID:   func(server meraki.SwitchArpInspectionTrustedServer) string { return server.TrustedServerID },
+		Same: sameTrustedServer,
+		Create: func(server *meraki.SwitchArpInspectionTrustedServer) (*meraki.SwitchArpInspectionTrustedServer, error) {
+			return s.client.CreateSwitchArpInspectionTrustedServer(networkID, server)
+		},
+		Update: func(id string, server *meraki.SwitchArpInspectionTrustedServer) (*meraki.SwitchArpInspectionTrustedServer, error) {
+			return s.client.UpdateSwitchArpInspectionTrustedServer(networkID, id, server)
+		},
+		Delete: func(id string) error {
+			return s.client.DeleteSwitchArpInspectionTrustedServer(networkID, id)
+		},
+	}
+	_, reconcileDiags := reconciler.Reconcile(ctx, existing, desired)
+	diags.Append(reconcileDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	servers, err := s.client.GetSwitchArpInspectionTrustedServers(networkID)
+	if err != nil {
+		diags.AddError("Failed to get switch ARP inspection trusted servers", "Failed to get switch ARP inspection trusted servers: "+err.Error())
+		return diags
+	}
+	plan.ArpInspectionTrustedServers = trustedServersFromAPI(plan.ArpInspectionTrustedServers, servers)
+	return diags
+}
+
+func sameTrustedServer(a meraki.SwitchArpInspectionTrustedServer, b meraki.SwitchArpInspectionTrustedServer) bool {
+	return strings.EqualFold(a.Mac, b.Mac) && a.Vlan == b.Vlan && a.IPv4.Address == b.IPv4.Address
+}
+
+func dhcpServerPolicyToAPI(plan *SwitchDhcpServerPolicyResourceModel) *meraki.SwitchDhcpServerPolicy {
+	return &meraki.SwitchDhcpServerPolicy{
+		DefaultPolicy:  plan.DefaultPolicy.ValueString(),
+		AllowedServers: helpers.FromStringValues(plan.AllowedServers),
+		BlockedServers: helpers.FromStringValues(plan.BlockedServers),
+		Alerts: meraki.SwitchDhcpServerPolicyAlerts{
+			Email: meraki.SwitchDhcpServerPolicyEmailAlerts{Enabled: plan.AlertsEmailEnabled.ValueBool()},
+		},
+		ArpInspection: meraki.SwitchDhcpServerPolicyArpInspection{Enabled: plan.ArpInspectionEnabled.ValueBool()},
+	}
+}
+
+func dhcpServerPolicyFromAPI(policy *meraki.SwitchDhcpServerPolicy, state *SwitchDhcpServerPolicyResourceModel) {
+	state.DefaultPolicy = types.StringValue(policy.DefaultPolicy)
+	state.AllowedServers = helpers.KeepCaseValues(state.AllowedServers, policy.AllowedServers)
+	state.BlockedServers = helpers.KeepCaseValues(state.BlockedServers, policy.BlockedServers)
+	state.AlertsEmailEnabled = types.BoolValue(policy.Alerts.Email.Enabled)
+	state.ArpInspectionEnabled = types.BoolValue(policy.ArpInspection.Enabled)
+}
+
+func trustedServerToAPI(server SwitchArpInspectionTrustedServerModel) meraki.SwitchArpInspectionTrustedServer {
+	return meraki.SwitchArpInspectionTrustedServer{
+		Mac:  server.Mac.ValueString(),
+		Vlan: server.Vlan.ValueInt64(),
+		IPv4: meraki.SwitchArpInspectionTrustedServerIPv4{Address: server.IPv4Address.ValueString()},
+	}
+}
+
+// trustedServersFromAPI lists the servers in the order of prior, as the API does not keep the order
+// they were created in. Servers that are not in prior are appended.
+func trustedServersFromAPI(prior []SwitchArpInspectionTrustedServerModel, servers []meraki.SwitchArpInspectionTrustedServer) []SwitchArpInspectionTrustedServerModel {
+	var result []SwitchArpInspectionTrustedServerModel
+	used := make([]bool, len(servers))
+	for _, server := range prior {
+		desired := trustedServerToAPI(server)
+		for j, current := range servers {
+			if !used[j] && sameTrustedServer(current, desired) {
+				used[j] = true
+				result = append(result, SwitchArpInspectionTrustedServerModel{
+					Mac:         helpers.KeepCase(server.Mac, current.Mac),
+					Vlan:        types.Int64Value(current.Vlan),
+					IPv4Address: types.StringValue(current.IPv4.Address),
+				})
+				break
+			}
+		}
+	}
+	for j, current := range servers {
+		if !used[j] {
+			result = append(result, SwitchArpInspectionTrustedServerModel{
+				Mac:         types.StringValue(current.Mac),
+				Vlan:        types.Int64Value(current.Vlan),
+				IPv4Address: types.StringValue(current.IPv4.Address),
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,261 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &switchDscpToCosMappingsResource{}
+	_ resource.ResourceWithConfigure      = &switchDscpToCosMappingsResource{}
+	_ resource.ResourceWithImportState    = &switchDscpToCosMappingsResource{}
+	_ resource.ResourceWithValidateConfig = &switchDscpToCosMappingsResource{}
+)
+
+func NewSwitchDscpToCosMappingsResource() resource.Resource {
+	return &switchDscpToCosMappingsResource{}
+}
+
+type switchDscpToCosMappingsResource struct {
+	client meraki.Client
+}
+
+type SwitchDscpToCosMappingsResourceModel struct {
+	ID        types.String                  `tfsdk:"id"`
+	NetworkID types.String                  `tfsdk:"network_id"`
+	Mappings  []SwitchDscpToCosMappingModel `tfsdk:"mappings"`
+}
+
+type SwitchDscpToCosMappingModel struct {
+	Dscp  types.Int64  `tfsdk:"dscp"`
+	Cos   types.Int64  `tfsdk:"cos"`
+	Title types.String `tfsdk:"title"`
+}
+
+func (s *switchDscpToCosMappingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_dscp_to_cos_mappings"
+}
+
+func (s *switchDscpToCosMappingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the DSCP to CoS mappings of the switches of a network. All mappings are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mappings": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The DSCP to CoS mappings, DSCP values that are not mapped use the default mapping",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dscp": schema.Int64Attribute{
+							Required:    true,
+							Description: "The DSCP value, between 0 and 63",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 63),
+							},
+						},
+						"cos": schema.Int64Attribute{
+							Required:    true,
+							Description: "The CoS queue the DSCP value is mapped to, between 0 and 5",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 5),
+							},
+						},
+						"title": schema.StringAttribute{
+							Optional:    true,
+							Description: "A label for the mapping",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *switchDscpToCosMappingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch DSCP to CoS mappings resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch DSCP to CoS mappings resource")
+}
+
+func (s *switchDscpToCosMappingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchDscpToCosMappingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[int64]bool{}
+	for i, mapping := range config.Mappings {
+		if mapping.Dscp.IsNull() || mapping.Dscp.IsUnknown() {
+			continue
+		}
+		if seen[mapping.Dscp.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("mappings").AtListIndex(i).AtName("dscp"),
+				"Duplicate DSCP value",
+				fmt.Sprintf("DSCP value %d is mapped more than once", mapping.Dscp.ValueInt64()),
+			)
+		}
+		seen[mapping.Dscp.ValueInt64()] = true
+	}
+}
+
+func (s *switchDscpToCosMappingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch DSCP to CoS mappings resource")
+	var plan SwitchDscpToCosMappingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := s.client.UpdateSwitchDscpToCosMappings(plan.NetworkID.ValueString(), dscpToCosMappingsToAPI(plan.Mappings))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch DSCP to CoS mappings", "Failed to update switch DSCP to CoS mappings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	plan.Mappings = dscpToCosMappingsFromAPI(mappings.Mappings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch DSCP to CoS mappings resource")
+}
+
+func (s *switchDscpToCosMappingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch DSCP to CoS mappings resource")
+	var state SwitchDscpToCosMappingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := s.client.GetSwitchDscpToCosMappings(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch DSCP to CoS mappings", "Failed to get switch DSCP to CoS mappings: "+err.Error())
+		return
+	}
+
+	state.Mappings = dscpToCosMappingsFromAPI(mappings.Mappings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch DSCP to CoS mappings resource")
+}
+
+func (s *switchDscpToCosMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch DSCP to CoS mappings resource")
+	var plan SwitchDscpToCosMappingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := s.client.UpdateSwitchDscpToCosMappings(plan.NetworkID.ValueString(), dscpToCosMappingsToAPI(plan.Mappings))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch DSCP to CoS mappings", "Failed to update switch DSCP to CoS mappings: "+err.Error())
+		return
+	}
+
+	plan.Mappings = dscpToCosMappingsFromAPI(mappings.Mappings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch DSCP to CoS mappings resource")
+}
+
+func (s *switchDscpToCosMappingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch DSCP to CoS mappings resource")
+	var state SwitchDscpToCosMappingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.UpdateSwitchDscpToCosMappings(state.NetworkID.ValueString(), &meraki.SwitchDscpToCosMappings{
+		Mappings: []meraki.SwitchDscpToCosMapping{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch DSCP to CoS mappings", "Failed to delete switch DSCP to CoS mappings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch DSCP to CoS mappings resource")
+}
+
+func (s *switchDscpToCosMappingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func dscpToCosMappingsToAPI(mappings []SwitchDscpToCosMappingModel) *meraki.SwitchDscpToCosMappings {
+	result := &meraki.SwitchDscpToCosMappings{
+		Mappings: make([]meraki.SwitchDscpToCosMapping, 0, len(mappings)),
+	}
+	for _, mapping := range mappings {
+		result.Mappings = append(result.Mappings, meraki.SwitchDscpToCosMapping{
+			Dscp:  mapping.Dscp.ValueInt64(),
+			Cos:   mapping.Cos.ValueInt64(),
+			Title: mapping.Title.ValueString(),
+		})
+	}
+	return result
+}
+
+func dscpToCosMappingsFromAPI(mappings []meraki.SwitchDscpToCosMapping) []SwitchDscpToCosMappingModel {
+	result := make([]SwitchDscpToCosMappingModel, 0, len(mappings))
+	for _, mapping := range mappings {
+		result = append(result, SwitchDscpToCosMappingModel{
+			Dscp:  types.Int64Value(mapping.Dscp),
+			Cos:   types.Int64Value(mapping.Cos),
+			Title: helpers.StringValueOrNull(mapping.Title),
+		})
+	}
+	return result
+}
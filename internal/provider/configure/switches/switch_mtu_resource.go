@@ -0,0 +1,268 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &switchMtuResource{}
+	_ resource.ResourceWithConfigure      = &switchMtuResource{}
+	_ resource.ResourceWithImportState    = &switchMtuResource{}
+	_ resource.ResourceWithValidateConfig = &switchMtuResource{}
+)
+
+func NewSwitchMtuResource() resource.Resource {
+	return &switchMtuResource{}
+}
+
+type switchMtuResource struct {
+	client meraki.Client
+}
+
+type SwitchMtuResourceModel struct {
+	ID             types.String             `tfsdk:"id"`
+	NetworkID      types.String             `tfsdk:"network_id"`
+	DefaultMtuSize types.Int64              `tfsdk:"default_mtu_size"`
+	Overrides      []SwitchMtuOverrideModel `tfsdk:"overrides"`
+}
+
+type SwitchMtuOverrideModel struct {
+	Switches       []types.String `tfsdk:"switches"`
+	SwitchProfiles []types.String `tfsdk:"switch_profiles"`
+	MtuSize        types.Int64    `tfsdk:"mtu_size"`
+}
+
+func (s *switchMtuResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_mtu"
+}
+
+func (s *switchMtuResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the MTU of the switches of a network. The default MTU of 9578 is restored on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_mtu_size": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The MTU of the switches of the network, between 1280 and 9578. Defaults to 9578",
+				Default:     int64default.StaticInt64(9578),
+				Validators: []validator.Int64{
+					int64validator.Between(1280, 9578),
+				},
+			},
+			"overrides": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The MTU of specific switches or switch profiles, which use the default MTU when not listed",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"switches": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The serials of the switches, can not be combined with switch_profiles",
+						},
+						"switch_profiles": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the switch profiles of a configuration template, can not be combined with switches",
+						},
+						"mtu_size": schema.Int64Attribute{
+							Required:    true,
+							Description: "The MTU, between 1280 and 9578",
+							Validators: []validator.Int64{
+								int64validator.Between(1280, 9578),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *switchMtuResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch MTU resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch MTU resource")
+}
+
+func (s *switchMtuResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchMtuResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, override := range config.Overrides {
+		if (len(override.Switches) == 0) == (len(override.SwitchProfiles) == 0) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("overrides").AtListIndex(i),
+				"Invalid MTU override",
+				"exactly one of switches or switch_profiles must be set",
+			)
+		}
+	}
+}
+
+func (s *switchMtuResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch MTU resource")
+	var plan SwitchMtuResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mtu, err := s.client.UpdateSwitchMtu(plan.NetworkID.ValueString(), switchMtuToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch MTU settings", "Failed to update switch MTU settings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	switchMtuFromAPI(mtu, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch MTU resource")
+}
+
+func (s *switchMtuResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch MTU resource")
+	var state SwitchMtuResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mtu, err := s.client.GetSwitchMtu(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch MTU settings", "Failed to get switch MTU settings: "+err.Error())
+		return
+	}
+
+	switchMtuFromAPI(mtu, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch MTU resource")
+}
+
+func (s *switchMtuResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch MTU resource")
+	var plan SwitchMtuResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mtu, err := s.client.UpdateSwitchMtu(plan.NetworkID.ValueString(), switchMtuToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch MTU settings", "Failed to update switch MTU settings: "+err.Error())
+		return
+	}
+
+	switchMtuFromAPI(mtu, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch MTU resource")
+}
+
+func (s *switchMtuResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch MTU resource")
+	var state SwitchMtuResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.UpdateSwitchMtu(state.NetworkID.ValueString(), &meraki.SwitchMtu{
+		DefaultMtuSize: 9578,
+		Overrides:      []meraki.SwitchMtuOverride{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch MTU settings", "Failed to delete switch MTU settings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch MTU resource")
+}
+
+func (s *switchMtuResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func switchMtuToAPI(plan *SwitchMtuResourceModel) *meraki.SwitchMtu {
+	mtu := &meraki.SwitchMtu{
+		DefaultMtuSize: plan.DefaultMtuSize.ValueInt64(),
+		Overrides:      make([]meraki.SwitchMtuOverride, 0, len(plan.Overrides)),
+	}
+	for _, override := range plan.Overrides {
+		mtu.Overrides = append(mtu.Overrides, meraki.SwitchMtuOverride{
+			Switches:       helpers.FromStringValues(override.Switches),
+			SwitchProfiles: helpers.FromStringValues(override.SwitchProfiles),
+			MtuSize:        override.MtuSize.ValueInt64(),
+		})
+	}
+	return mtu
+}
+
+func switchMtuFromAPI(mtu *meraki.SwitchMtu, state *SwitchMtuResourceModel) {
+	state.DefaultMtuSize = types.Int64Value(mtu.DefaultMtuSize)
+	state.Overrides = nil
+	for _, override := range mtu.Overrides {
+		state.Overrides = append(state.Overrides, SwitchMtuOverrideModel{
+			Switches:       helpers.NilIfEmpty(helpers.ToStringValues(override.Switches)),
+			SwitchProfiles: helpers.NilIfEmpty(helpers.ToStringValues(override.SwitchProfiles)),
+			MtuSize:        types.Int64Value(override.MtuSize),
+		})
+	}
+}
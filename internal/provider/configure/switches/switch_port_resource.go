@@ -0,0 +1,562 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &switchPortResource{}
+	_ resource.ResourceWithConfigure      = &switchPortResource{}
+	_ resource.ResourceWithImportState    = &switchPortResource{}
+	_ resource.ResourceWithValidateConfig = &switchPortResource{}
+)
+
+func NewSwitchPortResource() resource.Resource {
+	return &switchPortResource{}
+}
+
+type switchPortResource struct {
+	client meraki.Client
+}
+
+type SwitchPortResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	Serial                  types.String   `tfsdk:"serial"`
+	PortID                  types.String   `tfsdk:"port_id"`
+	Name                    types.String   `tfsdk:"name"`
+	Tags                    []types.String `tfsdk:"tags"`
+	Enabled                 types.Bool     `tfsdk:"enabled"`
+	PoeEnabled              types.Bool     `tfsdk:"poe_enabled"`
+	Type                    types.String   `tfsdk:"type"`
+	Vlan                    types.Int64    `tfsdk:"vlan"`
+	VoiceVlan               types.Int64    `tfsdk:"voice_vlan"`
+	AllowedVlans            types.String   `tfsdk:"allowed_vlans"`
+	IsolationEnabled        types.Bool     `tfsdk:"isolation_enabled"`
+	RstpEnabled             types.Bool     `tfsdk:"rstp_enabled"`
+	StpGuard                types.String   `tfsdk:"stp_guard"`
+	LinkNegotiation         types.String   `tfsdk:"link_negotiation"`
+	PortScheduleID          types.String   `tfsdk:"port_schedule_id"`
+	AccessPolicyType        types.String   `tfsdk:"access_policy_type"`
+	AccessPolicyNumber      types.Int64    `tfsdk:"access_policy_number"`
+	MacAllowList            []types.String `tfsdk:"mac_allow_list"`
+	StickyMacAllowList      []types.String `tfsdk:"sticky_mac_allow_list"`
+	StickyMacAllowListLimit types.Int64    `tfsdk:"sticky_mac_allow_list_limit"`
+	StormControlEnabled     types.Bool     `tfsdk:"storm_control_enabled"`
+	Udld                    types.String   `tfsdk:"udld"`
+}
+
+func (s *switchPortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_port"
+}
+
+// switchPortAttributes returns the attributes of the configuration of a switch port, with the
+// defaults a port is reset to.
+func switchPortAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The name of the port",
+		},
+		"tags": schema.ListAttribute{
+			Optional:    true,
+			Description: "The tags of the port",
+			ElementType: types.StringType,
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the port is enabled. Defaults to true",
+			Default:     booldefault.StaticBool(true),
+		},
+		"poe_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether PoE is enabled on the port. Defaults to true",
+			Default:     booldefault.StaticBool(true),
+		},
+		"type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The type of the port, can be 'access' or 'trunk'. Defaults to 'access'",
+			Default:     stringdefault.StaticString("access"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("access", "trunk"),
+			},
+		},
+		"vlan": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The VLAN of an access port or the native VLAN of a trunk port. Defaults to 1",
+			Default:     int64default.StaticInt64(1),
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+		},
+		"voice_vlan": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The voice VLAN of an access port",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+		},
+		"allowed_vlans": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The VLANs allowed on a trunk port, 'all' or a comma separated list of VLAN IDs and ranges, e.g. '1,3,5-10'. Defaults to 'all'",
+			Default:     stringdefault.StaticString("all"),
+			Validators: []validator.String{
+				validators.VlanList(),
+			},
+		},
+		"isolation_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the port is isolated from other isolated ports. Defaults to false",
+			Default:     booldefault.StaticBool(false),
+		},
+		"rstp_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether RSTP is enabled on the port. Defaults to true",
+			Default:     booldefault.StaticBool(true),
+		},
+		"stp_guard": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The STP guard of the port, can be 'disabled', 'root guard', 'bpdu guard' or 'loop guard'. Defaults to 'disabled'",
+			Default:     stringdefault.StaticString("disabled"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("disabled", "root guard", "bpdu guard", "loop guard"),
+			},
+		},
+		"link_negotiation": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The link speed and duplex of the port, e.g. 'Auto negotiate' or '100 Megabit full duplex (forced)'. Defaults to 'Auto negotiate'",
+			Default:     stringdefault.StaticString("Auto negotiate"),
+		},
+		"port_schedule_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of the port schedule of the port",
+		},
+		"access_policy_type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The access policy of an access port, can be 'Open', 'Custom access policy', 'MAC allow list' or 'Sticky MAC allow list'. Defaults to 'Open'",
+			Default:     stringdefault.StaticString("Open"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("Open", "Custom access policy", "MAC allow list", "Sticky MAC allow list"),
+			},
+		},
+		"access_policy_number": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The number of the access policy, required when access_policy_type is 'Custom access policy'",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"mac_allow_list": schema.ListAttribute{
+			Optional:    true,
+			Description: "The MAC addresses allowed on the port, required when access_policy_type is 'MAC allow list'",
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(validators.MACAddress()),
+			},
+		},
+		"sticky_mac_allow_list": schema.ListAttribute{
+			Optional:    true,
+			Description: "The MAC addresses initially allowed on the port when access_policy_type is 'Sticky MAC allow list'",
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(validators.MACAddress()),
+			},
+		},
+		"sticky_mac_allow_list_limit": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The maximum number of MAC addresses learned on the port, required when access_policy_type is 'Sticky MAC allow list'",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"storm_control_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether storm control is enabled on the port. Defaults to false",
+			Default:     booldefault.StaticBool(false),
+		},
+		"udld": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The action taken when UDLD detects a unidirectional link, can be 'Alert only' or 'Enforce'. Defaults to 'Alert only'",
+			Default:     stringdefault.StaticString("Alert only"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("Alert only", "Enforce"),
+			},
+		},
+	}
+}
+
+func (s *switchPortResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := switchPortAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The ID of the resource in the form serial/port_id",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["serial"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The serial number of the switch",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["port_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the port, e.g. '1' or '1_MA-MOD-4X10G_1' for the ports of a module",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the configuration of a switch port. The port is reset to its defaults on destroy.",
+		Attributes:  attributes,
+	}
+}
+
+func (s *switchPortResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch port resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch port resource")
+}
+
+func (s *switchPortResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchPortResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSwitchPort(path.Empty(), &config)...)
+}
+
+func (s *switchPortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch port resource")
+	var plan SwitchPortResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := s.client.UpdateSwitchPort(plan.Serial.ValueString(), plan.PortID.ValueString(), switchPortToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch port", "Failed to update switch port: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Serial.ValueString() + "/" + plan.PortID.ValueString())
+	switchPortFromAPI(port, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch port resource")
+}
+
+func (s *switchPortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch port resource")
+	var state SwitchPortResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := s.client.GetSwitchPort(state.Serial.ValueString(), state.PortID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch port", "Failed to get switch port: "+err.Error())
+		return
+	}
+
+	switchPortFromAPI(port, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch port resource")
+}
+
+func (s *switchPortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch port resource")
+	var plan SwitchPortResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := s.client.UpdateSwitchPort(plan.Serial.ValueString(), plan.PortID.ValueString(), switchPortToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch port", "Failed to update switch port: "+err.Error())
+		return
+	}
+
+	switchPortFromAPI(port, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch port resource")
+}
+
+func (s *switchPortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch port resource")
+	var state SwitchPortResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.UpdateSwitchPort(state.Serial.ValueString(), state.PortID.ValueString(), defaultSwitchPort())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to reset switch port", "Failed to reset switch port: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch port resource")
+}
+
+func (s *switchPortResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serial, portID, err := helpers.SplitImportID(req.ID, "serial/port_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), serial)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("port_id"), portID)...)
+}
+
+// validateSwitchPort checks the attributes that depend on the type and the access policy of a port,
+// reporting errors relative to base.
+func validateSwitchPort(base path.Path, config *SwitchPortResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// type and access_policy_type fall back to their defaults when not configured
+	if !config.Type.IsUnknown() {
+		if config.Type.ValueString() == "trunk" {
+			if !config.VoiceVlan.IsNull() {
+				diags.AddAttributeError(base.AtName("voice_vlan"), "Invalid switch port configuration", "voice_vlan can only be set on access ports")
+			}
+			if !config.AccessPolicyType.IsNull() && !config.AccessPolicyType.IsUnknown() && config.AccessPolicyType.ValueString() != "Open" {
+				diags.AddAttributeError(base.AtName("access_policy_type"), "Invalid switch port configuration", "access policies can only be set on access ports")
+			}
+			// an invalid allowed_vlans is reported by its validator
+			if !config.Vlan.IsNull() && !config.Vlan.IsUnknown() && !config.AllowedVlans.IsNull() && !config.AllowedVlans.IsUnknown() {
+				allowed, err := validators.ParseVlanList(config.AllowedVlans.ValueString())
+				if err == nil && !validators.VlanListContains(allowed, config.Vlan.ValueInt64()) {
+					diags.AddAttributeError(
+						base.AtName("vlan"),
+						"Invalid switch port configuration",
+						fmt.Sprintf("native VLAN %d is not one of the allowed VLANs %s", config.Vlan.ValueInt64(), config.AllowedVlans.ValueString()),
+					)
+				}
+			}
+		} else if !config.AllowedVlans.IsNull() {
+			diags.AddAttributeError(base.AtName("allowed_vlans"), "Invalid switch port configuration", "allowed_vlans can only be set on trunk ports")
+		}
+	}
+
+	if config.AccessPolicyType.IsUnknown() {
+		return diags
+	}
+	policy := config.AccessPolicyType.ValueString()
+	checks := []struct {
+		name   string
+		set    bool
+		policy string
+	}{
+		{"access_policy_number", !config.AccessPolicyNumber.IsNull(), "Custom access policy"},
+		{"mac_allow_list", config.MacAllowList != nil, "MAC allow list"},
+		{"sticky_mac_allow_list_limit", !config.StickyMacAllowListLimit.IsNull(), "Sticky MAC allow list"},
+	}
+	for _, check := range checks {
+		if check.set != (policy == check.policy) {
+			diags.AddAttributeError(
+				base.AtName(check.name),
+				"Invalid switch port access policy",
+				fmt.Sprintf("%s must be set if and only if access_policy_type is '%s'", check.name, check.policy),
+			)
+		}
+	}
+	if config.StickyMacAllowList != nil && policy != "Sticky MAC allow list" {
+		diags.AddAttributeError(
+			base.AtName("sticky_mac_allow_list"),
+			"Invalid switch port access policy",
+			"sticky_mac_allow_list can only be set when access_policy_type is 'Sticky MAC allow list'",
+		)
+	}
+	return diags
+}
+
+// defaultSwitchPort returns the configuration a port is reset to on destroy.
+func defaultSwitchPort() *meraki.SwitchPort {
+	vlan := int64(1)
+	return &meraki.SwitchPort{
+		Name:                "",
+		Tags:                []string{},
+		Enabled:             true,
+		PoeEnabled:          true,
+		Type:                "access",
+		Vlan:                &vlan,
+		VoiceVlan:           nil,
+		AllowedVlans:        "all",
+		IsolationEnabled:    false,
+		RstpEnabled:         true,
+		StpGuard:            "disabled",
+		LinkNegotiation:     "Auto negotiate",
+		PortScheduleID:      nil,
+		AccessPolicyType:    "Open",
+		StormControlEnabled: false,
+		Udld:                "Alert only",
+	}
+}
+
+func switchPortToAPI(plan *SwitchPortResourceModel) *meraki.SwitchPort {
+	vlan := plan.Vlan.ValueInt64()
+	port := &meraki.SwitchPort{
+		Name:                plan.Name.ValueString(),
+		Tags:                helpers.FromStringValues(plan.Tags),
+		Enabled:             plan.Enabled.ValueBool(),
+		PoeEnabled:          plan.PoeEnabled.ValueBool(),
+		Type:                plan.Type.ValueString(),
+		Vlan:                &vlan,
+		IsolationEnabled:    plan.IsolationEnabled.ValueBool(),
+		RstpEnabled:         plan.RstpEnabled.ValueBool(),
+		StpGuard:            plan.StpGuard.ValueString(),
+		LinkNegotiation:     plan.LinkNegotiation.ValueString(),
+		StormControlEnabled: plan.StormControlEnabled.ValueBool(),
+		Udld:                plan.Udld.ValueString(),
+	}
+	if port.Type == "trunk" {
+		port.AllowedVlans = plan.AllowedVlans.ValueString()
+	} else {
+		port.AccessPolicyType = plan.AccessPolicyType.ValueString()
+	}
+	if !plan.VoiceVlan.IsNull() && !plan.VoiceVlan.IsUnknown() {
+		voiceVlan := plan.VoiceVlan.ValueInt64()
+		port.VoiceVlan = &voiceVlan
+	}
+	if !plan.PortScheduleID.IsNull() && !plan.PortScheduleID.IsUnknown() {
+		scheduleID := plan.PortScheduleID.ValueString()
+		port.PortScheduleID = &scheduleID
+	}
+
+	switch port.AccessPolicyType {
+	case "Custom access policy":
+		number := plan.AccessPolicyNumber.ValueInt64()
+		port.AccessPolicyNumber = &number
+	case "MAC allow list":
+		port.MacAllowList = helpers.FromStringValues(plan.MacAllowList)
+	case "Sticky MAC allow list":
+		limit := plan.StickyMacAllowListLimit.ValueInt64()
+		port.StickyMacAllowList = helpers.FromStringValues(plan.StickyMacAllowList)
+		port.StickyMacAllowListLimit = &limit
+	}
+	return port
+}
+
+func switchPortFromAPI(port *meraki.SwitchPort, state *SwitchPortResourceModel) {
+	state.Name = helpers.StringValueOrNull(port.Name)
+	state.Tags = helpers.NilIfEmpty(helpers.ToStringValues(port.Tags))
+	state.Enabled = types.BoolValue(port.Enabled)
+	state.PoeEnabled = types.BoolValue(port.PoeEnabled)
+	state.Type = types.StringValue(port.Type)
+	state.Vlan = types.Int64Null()
+	if port.Vlan != nil {
+		state.Vlan = types.Int64Value(*port.Vlan)
+	}
+	state.VoiceVlan = types.Int64Null()
+	if port.VoiceVlan != nil {
+		state.VoiceVlan = types.Int64Value(*port.VoiceVlan)
+	}
+	// the API reports allowed VLANs on access ports as well, they are only used on trunk ports
+	if port.Type == "trunk" || state.AllowedVlans.IsNull() || state.AllowedVlans.IsUnknown() {
+		state.AllowedVlans = types.StringValue(port.AllowedVlans)
+	}
+	state.IsolationEnabled = types.BoolValue(port.IsolationEnabled)
+	state.RstpEnabled = types.BoolValue(port.RstpEnabled)
+	state.StpGuard = types.StringValue(port.StpGuard)
+	state.LinkNegotiation = types.StringValue(port.LinkNegotiation)
+	state.PortScheduleID = types.StringNull()
+	if port.PortScheduleID != nil {
+		state.PortScheduleID = helpers.StringValueOrNull(*port.PortScheduleID)
+	}
+	state.StormControlEnabled = types.BoolValue(port.StormControlEnabled)
+	state.Udld = types.StringValue(port.Udld)
+
+	// access policies are not reported for trunk ports
+	state.AccessPolicyType = types.StringValue("Open")
+	if port.AccessPolicyType != "" {
+		state.AccessPolicyType = types.StringValue(port.AccessPolicyType)
+	}
+	state.AccessPolicyNumber = types.Int64Null()
+	state.StickyMacAllowListLimit = types.Int64Null()
+	var macAllowList, stickyMacAllowList []types.String
+	switch port.AccessPolicyType {
+	case "Custom access policy":
+		if port.AccessPolicyNumber != nil {
+			state.AccessPolicyNumber = types.Int64Value(*port.AccessPolicyNumber)
+		}
+	case "MAC allow list":
+		macAllowList = helpers.KeepCaseValues(state.MacAllowList, port.MacAllowList)
+	case "Sticky MAC allow list":
+		// the switch adds the MAC addresses it learns to the sticky list, only keep the configured ones
+		stickyMacAllowList = state.StickyMacAllowList
+		if port.StickyMacAllowListLimit != nil {
+			state.StickyMacAllowListLimit = types.Int64Value(*port.StickyMacAllowListLimit)
+		}
+	}
+	state.MacAllowList = macAllowList
+	state.StickyMacAllowList = stickyMacAllowList
+}
@@ -0,0 +1,552 @@
+package switches
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &switchPortsResource{}
+	_ resource.ResourceWithConfigure      = &switchPortsResource{}
+	_ resource.ResourceWithValidateConfig = &switchPortsResource{}
+	_ resource.ResourceWithModifyPlan     = &switchPortsResource{}
+)
+
+var portRangeRegexp = regexp.MustCompile(`^(\d+)-(\d+)$`)
+
+func NewSwitchPortsResource() resource.Resource {
+	return &switchPortsResource{}
+}
+
+type switchPortsResource struct {
+	client meraki.Client
+}
+
+type SwitchPortsResourceModel struct {
+	ID             types.String                       `tfsdk:"id"`
+	OrganizationID types.String                       `tfsdk:"organization_id"`
+	Serial         types.String                       `tfsdk:"serial"`
+	Ports          types.String                       `tfsdk:"ports"`
+	Template       *SwitchPortSettingsModel           `tfsdk:"template"`
+	Overrides      map[string]SwitchPortSettingsModel `tfsdk:"overrides"`
+	PortChecksums  types.Map                          `tfsdk:"port_checksums"`
+}
+
+type SwitchPortSettingsModel struct {
+	Name                    types.String   `tfsdk:"name"`
+	Tags                    []types.String `tfsdk:"tags"`
+	Enabled                 types.Bool     `tfsdk:"enabled"`
+	PoeEnabled              types.Bool     `tfsdk:"poe_enabled"`
+	Type                    types.String   `tfsdk:"type"`
+	Vlan                    types.Int64    `tfsdk:"vlan"`
+	VoiceVlan               types.Int64    `tfsdk:"voice_vlan"`
+	AllowedVlans            types.String   `tfsdk:"allowed_vlans"`
+	IsolationEnabled        types.Bool     `tfsdk:"isolation_enabled"`
+	RstpEnabled             types.Bool     `tfsdk:"rstp_enabled"`
+	StpGuard                types.String   `tfsdk:"stp_guard"`
+	LinkNegotiation         types.String   `tfsdk:"link_negotiation"`
+	PortScheduleID          types.String   `tfsdk:"port_schedule_id"`
+	AccessPolicyType        types.String   `tfsdk:"access_policy_type"`
+	AccessPolicyNumber      types.Int64    `tfsdk:"access_policy_number"`
+	MacAllowList            []types.String `tfsdk:"mac_allow_list"`
+	StickyMacAllowList      []types.String `tfsdk:"sticky_mac_allow_list"`
+	StickyMacAllowListLimit types.Int64    `tfsdk:"sticky_mac_allow_list_limit"`
+	StormControlEnabled     types.Bool     `tfsdk:"storm_control_enabled"`
+	Udld                    types.String   `tfsdk:"udld"`
+}
+
+func (s *switchPortsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_ports"
+}
+
+// switchPortOverrideAttributes returns the attributes of the port configuration without their defaults,
+// so that only the configured attributes of an override replace the ones of the template.
+func switchPortOverrideAttributes() map[string]schema.Attribute {
+	attributes := switchPortAttributes()
+	withoutDefault := func(description string) string {
+		description, _, _ = strings.Cut(description, ". Defaults to")
+		return description
+	}
+	for name, attribute := range attributes {
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			a.Computed, a.Default, a.Description = false, nil, withoutDefault(a.Description)
+			attributes[name] = a
+		case schema.BoolAttribute:
+			a.Computed, a.Default, a.Description = false, nil, withoutDefault(a.Description)
+			attributes[name] = a
+		case schema.Int64Attribute:
+			a.Computed, a.Default, a.Description = false, nil, withoutDefault(a.Description)
+			attributes[name] = a
+		}
+	}
+	return attributes
+}
+
+func (s *switchPortsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the configuration of a range of ports of a switch from a shared template with per-port overrides. " +
+			"Changed ports are applied through organization action batches. The ports are reset to their defaults on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form serial/ports, with the ports the resource was created with",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the organization of the switch, used to submit the action batches",
+			},
+			"serial": schema.StringAttribute{
+				Required:    true,
+				Description: "The serial number of the switch",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ports": schema.StringAttribute{
+				Required:    true,
+				Description: "The ports managed by the resource, a comma separated list of port IDs and ranges, e.g. '1-24,26'. Ports removed from the list are reset to their defaults",
+			},
+			"template": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The configuration applied to all ports",
+				Attributes:  switchPortAttributes(),
+			},
+			"overrides": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "The attributes that differ from the template, keyed by port ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: switchPortOverrideAttributes(),
+				},
+			},
+			"port_checksums": schema.MapAttribute{
+				Computed:    true,
+				Description: "The checksums of the configuration of each port, used to detect ports changed outside of Terraform",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (s *switchPortsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch ports resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch ports resource")
+}
+
+func (s *switchPortsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchPortsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Template != nil {
+		template := config.Template.port(config.Serial, types.StringNull())
+		resp.Diagnostics.Append(validateSwitchPort(path.Root("template"), &template)...)
+		for portID, override := range config.Overrides {
+			port := config.Template.merge(&override).port(config.Serial, types.StringValue(portID))
+			resp.Diagnostics.Append(validateSwitchPort(path.Root("overrides").AtMapKey(portID), &port)...)
+		}
+	}
+
+	if config.Ports.IsUnknown() {
+		return
+	}
+	portIDs, err := parsePortRange(config.Ports.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ports"), "Invalid port range", err.Error())
+		return
+	}
+	managed := make(map[string]bool, len(portIDs))
+	for _, portID := range portIDs {
+		managed[portID] = true
+	}
+	for portID := range config.Overrides {
+		if !managed[portID] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("overrides").AtMapKey(portID),
+				"Invalid port override",
+				fmt.Sprintf("port %s is not within the ports %s", portID, config.Ports.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan plans the checksums of the configuration of every port, so that ports changed
+// outside of Terraform show up as a change of port_checksums.
+func (s *switchPortsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan SwitchPortsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := desiredSwitchPorts(&plan)
+	if err != nil {
+		// reported by ValidateConfig
+		return
+	}
+	checksums := make(map[string]string, len(desired))
+	for portID, port := range desired {
+		checksums[portID] = switchPortChecksum(&port)
+	}
+	planned, diags := types.MapValueFrom(ctx, types.StringType, checksums)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("port_checksums"), planned)...)
+}
+
+func (s *switchPortsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch ports resource")
+	var plan SwitchPortsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.apply(ctx, &plan, map[string]string{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(plan.Serial.ValueString() + "/" + plan.Ports.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch ports resource")
+}
+
+func (s *switchPortsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch ports resource")
+	var state SwitchPortsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ports, err := s.client.GetSwitchPorts(state.Serial.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch ports", "Failed to get switch ports: "+err.Error())
+		return
+	}
+
+	desired, err := desiredSwitchPorts(&state)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid port range", err.Error())
+		return
+	}
+
+	// the checksum of a port read back with the desired configuration as prior equals the
+	// planned checksum as long as the port was not changed outside of Terraform
+	checksums := make(map[string]string, len(desired))
+	for _, port := range ports {
+		actual, ok := desired[port.PortID]
+		if !ok {
+			continue
+		}
+		switchPortFromAPI(&port, &actual)
+		checksums[port.PortID] = switchPortChecksum(&actual)
+	}
+	var diags diag.Diagnostics
+	state.PortChecksums, diags = types.MapValueFrom(ctx, types.StringType, checksums)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch ports resource")
+}
+
+func (s *switchPortsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch ports resource")
+	var plan, state SwitchPortsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied := map[string]string{}
+	resp.Diagnostics.Append(state.PortChecksums.ElementsAs(ctx, &applied, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.apply(ctx, &plan, applied)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch ports resource")
+}
+
+func (s *switchPortsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch ports resource")
+	var state SwitchPortsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portIDs, err := parsePortRange(state.Ports.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid port range", err.Error())
+		return
+	}
+	actions := make([]meraki.ActionBatchAction, 0, len(portIDs))
+	for _, portID := range portIDs {
+		actions = append(actions, switchPortAction(state.Serial.ValueString(), portID, defaultSwitchPort()))
+	}
+
+	err = s.runActions(ctx, state.OrganizationID.ValueString(), actions)
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to reset switch ports", "Failed to reset switch ports: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch ports resource")
+}
+
+// apply updates the ports whose planned checksum differs from the applied one and resets the
+// ports that were removed from the range, then records the planned checksums.
+func (s *switchPortsResource) apply(ctx context.Context, plan *SwitchPortsResourceModel, applied map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desired, err := desiredSwitchPorts(plan)
+	if err != nil {
+		diags.AddAttributeError(path.Root("ports"), "Invalid port range", err.Error())
+		return diags
+	}
+	portIDs, _ := parsePortRange(plan.Ports.ValueString())
+
+	serial := plan.Serial.ValueString()
+	checksums := make(map[string]string, len(desired))
+	var actions []meraki.ActionBatchAction
+	for _, portID := range portIDs {
+		port := desired[portID]
+		checksums[portID] = switchPortChecksum(&port)
+		if applied[portID] != checksums[portID] {
+			actions = append(actions, switchPortAction(serial, portID, switchPortToAPI(&port)))
+		}
+	}
+	for portID := range applied {
+		if _, ok := desired[portID]; !ok {
+			actions = append(actions, switchPortAction(serial, portID, defaultSwitchPort()))
+		}
+	}
+
+	tflog.Debug(ctx, "Applying switch ports", map[string]any{"changed": len(actions), "managed": len(portIDs)})
+	if err := s.runActions(ctx, plan.OrganizationID.ValueString(), actions); err != nil {
+		diags.AddError("Failed to update switch ports", "Failed to update switch ports: "+err.Error())
+		return diags
+	}
+
+	var checksumDiags diag.Diagnostics
+	plan.PortChecksums, checksumDiags = types.MapValueFrom(ctx, types.StringType, checksums)
+	diags.Append(checksumDiags...)
+	return diags
+}
+
+// runActions submits the actions as synchronous action batches, which are limited in size.
+func (s *switchPortsResource) runActions(ctx context.Context, organizationID string, actions []meraki.ActionBatchAction) error {
+	for start := 0; start < len(actions); start += meraki.MaxSynchronousActionBatchActions {
+		end := min(start+meraki.MaxSynchronousActionBatchActions, len(actions))
+		batch, err := s.client.CreateOrganizationActionBatch(organizationID, &meraki.ActionBatch{
+			Confirmed:   true,
+			Synchronous: true,
+			Actions:     actions[start:end],
+		})
+		if err != nil {
+			return err
+		}
+		if err := batch.Err(); err != nil {
+			return err
+		}
+		tflog.Debug(ctx, "Applied action batch "+batch.ID)
+	}
+	return nil
+}
+
+func switchPortAction(serial string, portID string, port *meraki.SwitchPort) meraki.ActionBatchAction {
+	return meraki.ActionBatchAction{
+		Resource:  "/devices/" + serial + "/switch/ports/" + portID,
+		Operation: "update",
+		Body:      port,
+	}
+}
+
+// switchPortChecksum returns the checksum of the configuration sent to the API for a port.
+func switchPortChecksum(port *SwitchPortResourceModel) string {
+	body, _ := json.Marshal(switchPortToAPI(port))
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// desiredSwitchPorts returns the configuration of every port in the range, keyed by port ID.
+func desiredSwitchPorts(model *SwitchPortsResourceModel) (map[string]SwitchPortResourceModel, error) {
+	portIDs, err := parsePortRange(model.Ports.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]SwitchPortResourceModel, len(portIDs))
+	for _, portID := range portIDs {
+		settings := model.Template
+		if override, ok := model.Overrides[portID]; ok {
+			settings = model.Template.merge(&override)
+		}
+		result[portID] = settings.port(model.Serial, types.StringValue(portID))
+	}
+	return result, nil
+}
+
+// parsePortRange parses a list of port IDs and ranges such as "1-24,26". Port IDs that are not
+// numbers, such as "1_MA-MOD-4X10G_1", can only be listed one by one.
+func parsePortRange(value string) ([]string, error) {
+	var result []string
+	seen := map[string]bool{}
+	add := func(portID string) {
+		if !seen[portID] {
+			seen[portID] = true
+			result = append(result, portID)
+		}
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			return nil, fmt.Errorf("ports must be a comma separated list of port IDs and ranges, e.g. '1-24,26', got: %s", value)
+		}
+		match := portRangeRegexp.FindStringSubmatch(entry)
+		if match == nil {
+			add(entry)
+			continue
+		}
+		start, _ := strconv.Atoi(match[1])
+		end, _ := strconv.Atoi(match[2])
+		if start < 1 || start > end {
+			return nil, fmt.Errorf("invalid port range %q", entry)
+		}
+		for port := start; port <= end; port++ {
+			add(strconv.Itoa(port))
+		}
+	}
+	return result, nil
+}
+
+// merge returns the template with the configured attributes of override.
+func (t *SwitchPortSettingsModel) merge(override *SwitchPortSettingsModel) *SwitchPortSettingsModel {
+	return &SwitchPortSettingsModel{
+		Name:                    overrideValue(t.Name, override.Name),
+		Tags:                    overrideList(t.Tags, override.Tags),
+		Enabled:                 overrideValue(t.Enabled, override.Enabled),
+		PoeEnabled:              overrideValue(t.PoeEnabled, override.PoeEnabled),
+		Type:                    overrideValue(t.Type, override.Type),
+		Vlan:                    overrideValue(t.Vlan, override.Vlan),
+		VoiceVlan:               overrideValue(t.VoiceVlan, override.VoiceVlan),
+		AllowedVlans:            overrideValue(t.AllowedVlans, override.AllowedVlans),
+		IsolationEnabled:        overrideValue(t.IsolationEnabled, override.IsolationEnabled),
+		RstpEnabled:             overrideValue(t.RstpEnabled, override.RstpEnabled),
+		StpGuard:                overrideValue(t.StpGuard, override.StpGuard),
+		LinkNegotiation:         overrideValue(t.LinkNegotiation, override.LinkNegotiation),
+		PortScheduleID:          overrideValue(t.PortScheduleID, override.PortScheduleID),
+		AccessPolicyType:        overrideValue(t.AccessPolicyType, override.AccessPolicyType),
+		AccessPolicyNumber:      overrideValue(t.AccessPolicyNumber, override.AccessPolicyNumber),
+		MacAllowList:            overrideList(t.MacAllowList, override.MacAllowList),
+		StickyMacAllowList:      overrideList(t.StickyMacAllowList, override.StickyMacAllowList),
+		StickyMacAllowListLimit: overrideValue(t.StickyMacAllowListLimit, override.StickyMacAllowListLimit),
+		StormControlEnabled:     overrideValue(t.StormControlEnabled, override.StormControlEnabled),
+		Udld:                    overrideValue(t.Udld, override.Udld),
+	}
+}
+
+// port returns the configuration as the model of a single port.
+func (t *SwitchPortSettingsModel) port(serial types.String, portID types.String) SwitchPortResourceModel {
+	return SwitchPortResourceModel{
+		ID:                      types.StringNull(),
+		Serial:                  serial,
+		PortID:                  portID,
+		Name:                    t.Name,
+		Tags:                    t.Tags,
+		Enabled:                 t.Enabled,
+		PoeEnabled:              t.PoeEnabled,
+		Type:                    t.Type,
+		Vlan:                    t.Vlan,
+		VoiceVlan:               t.VoiceVlan,
+		AllowedVlans:            t.AllowedVlans,
+		IsolationEnabled:        t.IsolationEnabled,
+		RstpEnabled:             t.RstpEnabled,
+		StpGuard:                t.StpGuard,
+		LinkNegotiation:         t.LinkNegotiation,
+		PortScheduleID:          t.PortScheduleID,
+		AccessPolicyType:        t.AccessPolicyType,
+		AccessPolicyNumber:      t.AccessPolicyNumber,
+		MacAllowList:            t.MacAllowList,
+		StickyMacAllowList:      t.StickyMacAllowList,
+		StickyMacAllowListLimit: t.StickyMacAllowListLimit,
+		StormControlEnabled:     t.StormControlEnabled,
+		Udld:                    t.Udld,
+	}
+}
+
+func overrideValue[T interface{ IsNull() bool }](value T, override T) T {
+	if override.IsNull() {
+		return value
+	}
+	return override
+}
+
+func overrideList(values []types.String, override []types.String) []types.String {
+	if override == nil {
+		return values
+	}
+	return override
+}
@@ -0,0 +1,309 @@
+package switches
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParsePortRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "single port",
+			value: "1",
+			want:  []string{"1"},
+		},
+		{
+			name:  "range",
+			value: "1-4",
+			want:  []string{"1", "2", "3", "4"},
+		},
+		{
+			name:  "ports and ranges",
+			value: "1-3,8,10-11",
+			want:  []string{"1", "2", "3", "8", "10", "11"},
+		},
+		{
+			name:  "spaces around entries",
+			value: " 1-2 , 5 ",
+			want:  []string{"1", "2", "5"},
+		},
+		{
+			name:  "range of a single port",
+			value: "7-7",
+			want:  []string{"7"},
+		},
+		{
+			name:  "duplicates keep the first occurrence",
+			value: "3,1-4,2",
+			want:  []string{"3", "1", "2", "4"},
+		},
+		{
+			name:  "module port IDs",
+			value: "1_MA-MOD-4X10G_1,1_MA-MOD-4X10G_2,48",
+			want:  []string{"1_MA-MOD-4X10G_1", "1_MA-MOD-4X10G_2", "48"},
+		},
+		{
+			name:    "reversed range",
+			value:   "10-5",
+			wantErr: true,
+		},
+		{
+			name:    "range starting at 0",
+			value:   "0-4",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "empty entry",
+			value:   "1,,3",
+			wantErr: true,
+		},
+		{
+			name:    "trailing comma",
+			value:   "1-4,",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePortRange(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePortRange(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePortRange(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDesiredSwitchPorts(t *testing.T) {
+	template := &SwitchPortSettingsModel{
+		Name:         types.StringValue("access"),
+		Tags:         []types.String{types.StringValue("office")},
+		Type:         types.StringValue("access"),
+		Vlan:         types.Int64Value(10),
+		AllowedVlans: types.StringNull(),
+		VoiceVlan:    types.Int64Null(),
+	}
+
+	tests := []struct {
+		name      string
+		ports     string
+		overrides map[string]SwitchPortSettingsModel
+		want      map[string]SwitchPortSettingsModel
+		wantErr   bool
+	}{
+		{
+			name:  "template only",
+			ports: "1-2",
+			want: map[string]SwitchPortSettingsModel{
+				"1": *template,
+				"2": *template,
+			},
+		},
+		{
+			name:  "override replaces the configured attributes",
+			ports: "1-2",
+			overrides: map[string]SwitchPortSettingsModel{
+				"2": {
+					Name:         types.StringValue("uplink"),
+					Type:         types.StringValue("trunk"),
+					Vlan:         types.Int64Null(),
+					AllowedVlans: types.StringValue("10,20"),
+					VoiceVlan:    types.Int64Null(),
+				},
+			},
+			want: map[string]SwitchPortSettingsModel{
+				"1": *template,
+				"2": {
+					Name:         types.StringValue("uplink"),
+					Tags:         []types.String{types.StringValue("office")},
+					Type:         types.StringValue("trunk"),
+					Vlan:         types.Int64Value(10),
+					AllowedVlans: types.StringValue("10,20"),
+					VoiceVlan:    types.Int64Null(),
+				},
+			},
+		},
+		{
+			name:  "empty override list clears the template list",
+			ports: "1",
+			overrides: map[string]SwitchPortSettingsModel{
+				"1": {
+					Tags:         []types.String{},
+					Vlan:         types.Int64Null(),
+					AllowedVlans: types.StringNull(),
+					VoiceVlan:    types.Int64Null(),
+				},
+			},
+			want: map[string]SwitchPortSettingsModel{
+				"1": {
+					Name:         types.StringValue("access"),
+					Tags:         []types.String{},
+					Type:         types.StringValue("access"),
+					Vlan:         types.Int64Value(10),
+					AllowedVlans: types.StringNull(),
+					VoiceVlan:    types.Int64Null(),
+				},
+			},
+		},
+		{
+			name:  "override of a port outside the range is ignored",
+			ports: "1",
+			overrides: map[string]SwitchPortSettingsModel{
+				"5": {Name: types.StringValue("unused")},
+			},
+			want: map[string]SwitchPortSettingsModel{
+				"1": *template,
+			},
+		},
+		{
+			name:  "module ports",
+			ports: "1_MA-MOD-4X10G_1,1",
+			want: map[string]SwitchPortSettingsModel{
+				"1_MA-MOD-4X10G_1": *template,
+				"1":                *template,
+			},
+		},
+		{
+			name:    "invalid range",
+			ports:   "4-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &SwitchPortsResourceModel{
+				Serial:    types.StringValue("Q2XX-XXXX-XXXX"),
+				Ports:     types.StringValue(tt.ports),
+				Template:  template,
+				Overrides: tt.overrides,
+			}
+			got, err := desiredSwitchPorts(model)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("desiredSwitchPorts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			want := make(map[string]SwitchPortResourceModel, len(tt.want))
+			for portID, settings := range tt.want {
+				want[portID] = settings.port(model.Serial, types.StringValue(portID))
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("desiredSwitchPorts() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+// actionBatchClient records the action batches instead of submitting them.
+type actionBatchClient struct {
+	meraki.Client
+	batches []*meraki.ActionBatch
+}
+
+func (c *actionBatchClient) CreateOrganizationActionBatch(orgID string, batch *meraki.ActionBatch) (*meraki.ActionBatch, error) {
+	c.batches = append(c.batches, batch)
+	return &meraki.ActionBatch{ID: "1"}, nil
+}
+
+func TestSwitchPortsResourceUpdatePorts(t *testing.T) {
+	ctx := context.Background()
+	client := &actionBatchClient{}
+	r := &switchPortsResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	template := &SwitchPortSettingsModel{
+		Name: types.StringValue("access"),
+		Type: types.StringValue("access"),
+		Vlan: types.Int64Value(10),
+	}
+	prior := SwitchPortsResourceModel{
+		ID:             types.StringValue("Q2XX-XXXX-XXXX/1-2"),
+		OrganizationID: types.StringValue("123"),
+		Serial:         types.StringValue("Q2XX-XXXX-XXXX"),
+		Ports:          types.StringValue("1-2"),
+		Template:       template,
+	}
+	desired, err := desiredSwitchPorts(&prior)
+	if err != nil {
+		t.Fatalf("desiredSwitchPorts() error = %v", err)
+	}
+	checksums := map[string]string{}
+	for portID, port := range desired {
+		checksums[portID] = switchPortChecksum(&port)
+	}
+	prior.PortChecksums, _ = types.MapValueFrom(ctx, types.StringType, checksums)
+
+	planned := prior
+	planned.Ports = types.StringValue("1-4")
+	planned.PortChecksums = types.MapUnknown(types.StringType)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Fatalf("setting the state: %v", diags)
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatalf("setting the plan: %v", diags)
+	}
+
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() diagnostics = %v", resp.Diagnostics)
+	}
+
+	var updated SwitchPortsResourceModel
+	if diags := resp.State.Get(ctx, &updated); diags.HasError() {
+		t.Fatalf("reading the state: %v", diags)
+	}
+	if updated.ID != prior.ID {
+		t.Errorf("Update() id = %s, want %s", updated.ID, prior.ID)
+	}
+	if updated.Ports.ValueString() != "1-4" {
+		t.Errorf("Update() ports = %s, want 1-4", updated.Ports)
+	}
+	if len(updated.PortChecksums.Elements()) != 4 {
+		t.Errorf("Update() port_checksums = %s, want 4 ports", updated.PortChecksums)
+	}
+
+	var resources []string
+	for _, batch := range client.batches {
+		for _, action := range batch.Actions {
+			resources = append(resources, action.Resource)
+		}
+	}
+	sort.Strings(resources)
+	want := []string{
+		"/devices/Q2XX-XXXX-XXXX/switch/ports/3",
+		"/devices/Q2XX-XXXX-XXXX/switch/ports/4",
+	}
+	if !reflect.DeepEqual(resources, want) {
+		t.Errorf("Update() applied %s, want %s", strings.Join(resources, ", "), strings.Join(want, ", "))
+	}
+}
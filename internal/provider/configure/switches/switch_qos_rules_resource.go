@@ -0,0 +1,412 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &switchQosRulesResource{}
+	_ resource.ResourceWithConfigure      = &switchQosRulesResource{}
+	_ resource.ResourceWithImportState    = &switchQosRulesResource{}
+	_ resource.ResourceWithValidateConfig = &switchQosRulesResource{}
+)
+
+var qosPortRangeRegexp = regexp.MustCompile(`^\d{1,5}-\d{1,5}$`)
+
+func NewSwitchQosRulesResource() resource.Resource {
+	return &switchQosRulesResource{}
+}
+
+type switchQosRulesResource struct {
+	client meraki.Client
+}
+
+type SwitchQosRulesResourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	NetworkID types.String         `tfsdk:"network_id"`
+	Rules     []SwitchQosRuleModel `tfsdk:"rules"`
+	RuleIDs   types.List           `tfsdk:"rule_ids"`
+}
+
+type SwitchQosRuleModel struct {
+	Vlan         types.Int64  `tfsdk:"vlan"`
+	Protocol     types.String `tfsdk:"protocol"`
+	SrcPort      types.Int64  `tfsdk:"src_port"`
+	SrcPortRange types.String `tfsdk:"src_port_range"`
+	DstPort      types.Int64  `tfsdk:"dst_port"`
+	DstPortRange types.String `tfsdk:"dst_port_range"`
+	Dscp         types.Int64  `tfsdk:"dscp"`
+}
+
+func (s *switchQosRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_qos_rules"
+}
+
+func (s *switchQosRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete, ordered list of the switch quality of service rules of a network. " +
+			"Rules not in the list are deleted and all rules are deleted on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The quality of service rules, in the order they are evaluated",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vlan": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The VLAN of the incoming packet, any VLAN when not set",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4094),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The protocol of the incoming packet, can be 'ANY', 'TCP' or 'UDP'. Defaults to 'ANY'",
+							Default:     stringdefault.StaticString("ANY"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("ANY", "TCP", "UDP"),
+							},
+						},
+						"src_port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The source port of the incoming packet. Only allowed for TCP and UDP rules",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"src_port_range": schema.StringAttribute{
+							Optional:    true,
+							Description: "The source port range of the incoming packet, e.g. '70-80'. Only allowed for TCP and UDP rules",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(qosPortRangeRegexp, "must be a port range such as '70-80'"),
+							},
+						},
+						"dst_port": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The destination port of the incoming packet. Only allowed for TCP and UDP rules",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"dst_port_range": schema.StringAttribute{
+							Optional:    true,
+							Description: "The destination port range of the incoming packet, e.g. '70-80'. Only allowed for TCP and UDP rules",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(qosPortRangeRegexp, "must be a port range such as '70-80'"),
+							},
+						},
+						"dscp": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The DSCP tag set on the incoming packet, -1 to trust the incoming DSCP tag. Defaults to 0",
+							Default:     int64default.StaticInt64(0),
+							Validators: []validator.Int64{
+								int64validator.Between(-1, 63),
+							},
+						},
+					},
+				},
+			},
+			"rule_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the rules, in the same order as the rules",
+			},
+		},
+	}
+}
+
+func (s *switchQosRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch QoS rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch QoS rules resource")
+}
+
+func (s *switchQosRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchQosRulesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+
+		if !rule.SrcPort.IsNull() && !rule.SrcPortRange.IsNull() {
+			resp.Diagnostics.AddAttributeError(rulePath.AtName("src_port_range"), "Invalid switch QoS rule",
+				"src_port and src_port_range can not be set at the same time")
+		}
+		if !rule.DstPort.IsNull() && !rule.DstPortRange.IsNull() {
+			resp.Diagnostics.AddAttributeError(rulePath.AtName("dst_port_range"), "Invalid switch QoS rule",
+				"dst_port and dst_port_range can not be set at the same time")
+		}
+
+		// an unset protocol defaults to ANY, which does not match on ports
+		if rule.Protocol.IsUnknown() || (!rule.Protocol.IsNull() && rule.Protocol.ValueString() != "ANY") {
+			continue
+		}
+		for name, set := range map[string]bool{
+			"src_port":       !rule.SrcPort.IsNull(),
+			"src_port_range": !rule.SrcPortRange.IsNull(),
+			"dst_port":       !rule.DstPort.IsNull(),
+			"dst_port_range": !rule.DstPortRange.IsNull(),
+		} {
+			if set {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName(name), "Invalid switch QoS rule",
+					fmt.Sprintf("%s can only be set for TCP and UDP rules", name))
+			}
+		}
+	}
+}
+
+func (s *switchQosRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch QoS rules resource")
+	var plan SwitchQosRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.NetworkID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch QoS rules resource")
+}
+
+func (s *switchQosRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch QoS rules resource")
+	var state SwitchQosRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := s.client.GetSwitchQosRules(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch QoS rules", "Failed to get switch QoS rules: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(switchQosRulesFromAPI(ctx, rules, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch QoS rules resource")
+}
+
+func (s *switchQosRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch QoS rules resource")
+	var plan SwitchQosRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(s.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch QoS rules resource")
+}
+
+func (s *switchQosRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch QoS rules resource")
+	var state SwitchQosRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := state.NetworkID.ValueString()
+	rules, err := s.client.GetSwitchQosRules(networkID)
+	if meraki.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch QoS rules", "Failed to get switch QoS rules: "+err.Error())
+		return
+	}
+
+	for _, rule := range rules {
+		err := s.client.DeleteSwitchQosRule(networkID, rule.ID)
+		if err != nil && !meraki.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete switch QoS rule", "Failed to delete switch QoS rule: "+err.Error())
+			return
+		}
+	}
+	tflog.Info(ctx, "Deleted the switch QoS rules resource")
+}
+
+func (s *switchQosRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+// apply makes the rules of the network match the plan and sets their order.
+func (s *switchQosRulesResource) apply(ctx context.Context, plan *SwitchQosRulesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	networkID := plan.NetworkID.ValueString()
+
+	existing, err := s.client.GetSwitchQosRules(networkID)
+	if err != nil {
+		diags.AddError("Failed to get switch QoS rules", "Failed to get switch QoS rules: "+err.Error())
+		return diags
+	}
+
+	desired := make([]meraki.SwitchQosRule, 0, len(plan.Rules))
+	for _, rule := range plan.Rules {
+		desired = append(desired, switchQosRuleToAPI(rule))
+	}
+	reconciler := helpers.Reconciler[meraki.SwitchQosRule]{
+		Name: "switch QoS rule",
+		ID:   func(rule meraki.SwitchQosRule) string { return rule.ID },
+		Same: sameSwitchQosRule,
+		Create: func(rule *meraki.SwitchQosRule) (*meraki.SwitchQosRule, error) {
+			return s.client.CreateSwitchQosRule(networkID, rule)
+		},
+		Update: func(id string, rule *meraki.SwitchQosRule) (*meraki.SwitchQosRule, error) {
+			return s.client.UpdateSwitchQosRule(networkID, id, rule)
+		},
+		Delete: func(id string) error {
+			return s.client.DeleteSwitchQosRule(networkID, id)
+		},
+	}
+	ruleIDs, reconcileDiags := reconciler.Reconcile(ctx, existing, desired)
+	diags.Append(reconcileDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(ruleIDs) > 0 {
+		_, err = s.client.UpdateSwitchQosRulesOrder(networkID, &meraki.SwitchQosRulesOrder{RuleIDs: ruleIDs})
+		if err != nil {
+			diags.AddError("Failed to update switch QoS rules order", "Failed to update switch QoS rules order: "+err.Error())
+			return diags
+		}
+	}
+
+	rules, err := s.client.GetSwitchQosRules(networkID)
+	if err != nil {
+		diags.AddError("Failed to get switch QoS rules", "Failed to get switch QoS rules: "+err.Error())
+		return diags
+	}
+	diags.Append(switchQosRulesFromAPI(ctx, rules, plan)...)
+	return diags
+}
+
+func sameSwitchQosRule(a meraki.SwitchQosRule, b meraki.SwitchQosRule) bool {
+	return equalInt64Pointers(a.Vlan, b.Vlan) &&
+		strings.EqualFold(a.Protocol, b.Protocol) &&
+		equalInt64Pointers(a.SrcPort, b.SrcPort) &&
+		a.SrcPortRange == b.SrcPortRange &&
+		equalInt64Pointers(a.DstPort, b.DstPort) &&
+		a.DstPortRange == b.DstPortRange &&
+		a.Dscp == b.Dscp
+}
+
+func equalInt64Pointers(a *int64, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func switchQosRuleToAPI(rule SwitchQosRuleModel) meraki.SwitchQosRule {
+	return meraki.SwitchQosRule{
+		Vlan:         helpers.Int64PointerOrNil(rule.Vlan),
+		Protocol:     rule.Protocol.ValueString(),
+		SrcPort:      helpers.Int64PointerOrNil(rule.SrcPort),
+		SrcPortRange: rule.SrcPortRange.ValueString(),
+		DstPort:      helpers.Int64PointerOrNil(rule.DstPort),
+		DstPortRange: rule.DstPortRange.ValueString(),
+		Dscp:         rule.Dscp.ValueInt64(),
+	}
+}
+
+func switchQosRulesFromAPI(ctx context.Context, rules []meraki.SwitchQosRule, state *SwitchQosRulesResourceModel) diag.Diagnostics {
+	state.Rules = make([]SwitchQosRuleModel, 0, len(rules))
+	ruleIDs := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		state.Rules = append(state.Rules, SwitchQosRuleModel{
+			Vlan:         helpers.Int64ValueOrNull(rule.Vlan),
+			Protocol:     types.StringValue(strings.ToUpper(rule.Protocol)),
+			SrcPort:      helpers.Int64ValueOrNull(rule.SrcPort),
+			SrcPortRange: helpers.StringValueOrNull(rule.SrcPortRange),
+			DstPort:      helpers.Int64ValueOrNull(rule.DstPort),
+			DstPortRange: helpers.StringValueOrNull(rule.DstPortRange),
+			Dscp:         types.Int64Value(rule.Dscp),
+		})
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+
+	var diags diag.Diagnostics
+	state.RuleIDs, diags = types.ListValueFrom(ctx, types.StringType, ruleIDs)
+	return diags
+}
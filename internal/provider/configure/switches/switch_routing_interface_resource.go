@@ -0,0 +1,355 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+)
+
+var (
+	_ resource.Resource                   = &switchRoutingInterfaceResource{}
+	_ resource.ResourceWithConfigure      = &switchRoutingInterfaceResource{}
+	_ resource.ResourceWithImportState    = &switchRoutingInterfaceResource{}
+	_ resource.ResourceWithValidateConfig = &switchRoutingInterfaceResource{}
+)
+
+var ospfAreaRegexp = regexp.MustCompile(`^\d+$`)
+
+func NewSwitchRoutingInterfaceResource() resource.Resource {
+	return &switchRoutingInterfaceResource{}
+}
+
+type switchRoutingInterfaceResource struct {
+	client meraki.Client
+}
+
+type SwitchRoutingInterfaceResourceModel struct {
+	ID               types.String                       `tfsdk:"id"`
+	Serial           types.String                       `tfsdk:"serial"`
+	InterfaceID      types.String                       `tfsdk:"interface_id"`
+	Name             types.String                       `tfsdk:"name"`
+	Subnet           types.String                       `tfsdk:"subnet"`
+	InterfaceIP      types.String                       `tfsdk:"interface_ip"`
+	VlanID           types.Int64                        `tfsdk:"vlan_id"`
+	DefaultGateway   types.String                       `tfsdk:"default_gateway"`
+	MulticastRouting types.String                       `tfsdk:"multicast_routing"`
+	OspfSettings     *RoutingInterfaceOspfSettingsModel `tfsdk:"ospf_settings"`
+}
+
+type RoutingInterfaceOspfSettingsModel struct {
+	Area             types.String `tfsdk:"area"`
+	Cost             types.Int64  `tfsdk:"cost"`
+	IsPassiveEnabled types.Bool   `tfsdk:"is_passive_enabled"`
+}
+
+func (s *switchRoutingInterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_routing_interface"
+}
+
+// multicastRoutingAttribute returns the multicast routing mode of a routing interface.
+func multicastRoutingAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The multicast routing mode of the interface, can be 'disabled', 'enabled' or 'IGMP snooping querier'. Defaults to 'disabled'",
+		Default:     stringdefault.StaticString("disabled"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("disabled", "enabled", "IGMP snooping querier"),
+		},
+	}
+}
+
+// routingInterfaceOspfAttribute returns the OSPF settings of a routing interface, which are
+// disabled on the interface when not set.
+func routingInterfaceOspfAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "The OSPF settings of the interface, OSPF is disabled on the interface when not set",
+		Attributes: map[string]schema.Attribute{
+			"area": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the OSPF area of the interface, one of the areas of the network OSPF settings",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ospfAreaRegexp, "must be an OSPF area ID"),
+				},
+			},
+			"cost": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The path cost of the interface. Defaults to 1",
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"is_passive_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the interface only advertises its subnet without forming adjacencies. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (s *switchRoutingInterfaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a layer 3 interface of an MS switch.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form serial/interface_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				Required:    true,
+				Description: "The serial number of the switch",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the interface",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the interface",
+			},
+			"subnet": schema.StringAttribute{
+				Required:    true,
+				Description: "The network the interface is in, in CIDR notation",
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"interface_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address of the switch on the subnet",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The VLAN of the interface",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"default_gateway": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The next hop for traffic leaving the switch through this interface, only applicable and required for the first interface",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"multicast_routing": multicastRoutingAttribute(),
+			"ospf_settings":     routingInterfaceOspfAttribute(),
+		},
+	}
+}
+
+func (s *switchRoutingInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch routing interface resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch routing interface resource")
+}
+
+func (s *switchRoutingInterfaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRoutingInterface(config.Subnet, config.InterfaceIP, config.DefaultGateway)...)
+}
+
+func (s *switchRoutingInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch routing interface resource")
+	var plan SwitchRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routingInterface, err := s.client.CreateSwitchRoutingInterface(plan.Serial.ValueString(), routingInterfaceToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create switch routing interface", "Failed to create switch routing interface: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Serial.ValueString() + "/" + routingInterface.InterfaceID)
+	plan.InterfaceID = types.StringValue(routingInterface.InterfaceID)
+	routingInterfaceFromAPI(routingInterface, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch routing interface resource")
+}
+
+func (s *switchRoutingInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch routing interface resource")
+	var state SwitchRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routingInterface, err := s.client.GetSwitchRoutingInterface(state.Serial.ValueString(), state.InterfaceID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch routing interface", "Failed to get switch routing interface: "+err.Error())
+		return
+	}
+
+	routingInterfaceFromAPI(routingInterface, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch routing interface resource")
+}
+
+func (s *switchRoutingInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch routing interface resource")
+	var plan SwitchRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routingInterface, err := s.client.UpdateSwitchRoutingInterface(plan.Serial.ValueString(), plan.InterfaceID.ValueString(), routingInterfaceToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch routing interface", "Failed to update switch routing interface: "+err.Error())
+		return
+	}
+
+	routingInterfaceFromAPI(routingInterface, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch routing interface resource")
+}
+
+func (s *switchRoutingInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch routing interface resource")
+	var state SwitchRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.DeleteSwitchRoutingInterface(state.Serial.ValueString(), state.InterfaceID.ValueString())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch routing interface", "Failed to delete switch routing interface: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch routing interface resource")
+}
+
+func (s *switchRoutingInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serial, interfaceID, err := helpers.SplitImportID(req.ID, "serial/interface_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), serial)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_id"), interfaceID)...)
+}
+
+func routingInterfaceOspfToAPI(settings *RoutingInterfaceOspfSettingsModel) *meraki.SwitchRoutingInterfaceOspfSettings {
+	if settings == nil {
+		return &meraki.SwitchRoutingInterfaceOspfSettings{Area: "disabled", Cost: 1}
+	}
+	return &meraki.SwitchRoutingInterfaceOspfSettings{
+		Area:             settings.Area.ValueString(),
+		Cost:             settings.Cost.ValueInt64(),
+		IsPassiveEnabled: settings.IsPassiveEnabled.ValueBool(),
+	}
+}
+
+func routingInterfaceOspfFromAPI(settings *meraki.SwitchRoutingInterfaceOspfSettings) *RoutingInterfaceOspfSettingsModel {
+	if settings == nil || settings.Area == "" || settings.Area == "disabled" {
+		return nil
+	}
+	return &RoutingInterfaceOspfSettingsModel{
+		Area:             types.StringValue(settings.Area),
+		Cost:             types.Int64Value(settings.Cost),
+		IsPassiveEnabled: types.BoolValue(settings.IsPassiveEnabled),
+	}
+}
+
+func routingInterfaceToAPI(plan *SwitchRoutingInterfaceResourceModel) *meraki.SwitchRoutingInterface {
+	routingInterface := &meraki.SwitchRoutingInterface{
+		Name:             plan.Name.ValueString(),
+		Subnet:           plan.Subnet.ValueString(),
+		InterfaceIP:      plan.InterfaceIP.ValueString(),
+		VlanID:           plan.VlanID.ValueInt64(),
+		MulticastRouting: plan.MulticastRouting.ValueString(),
+		OspfSettings:     routingInterfaceOspfToAPI(plan.OspfSettings),
+	}
+	if !plan.DefaultGateway.IsUnknown() {
+		routingInterface.DefaultGateway = plan.DefaultGateway.ValueString()
+	}
+	return routingInterface
+}
+
+func routingInterfaceFromAPI(routingInterface *meraki.SwitchRoutingInterface, state *SwitchRoutingInterfaceResourceModel) {
+	state.Name = types.StringValue(routingInterface.Name)
+	state.Subnet = types.StringValue(routingInterface.Subnet)
+	state.InterfaceIP = types.StringValue(routingInterface.InterfaceIP)
+	state.VlanID = types.Int64Value(routingInterface.VlanID)
+	state.DefaultGateway = helpers.StringValueOrNull(routingInterface.DefaultGateway)
+	state.MulticastRouting = types.StringValue(routingInterface.MulticastRouting)
+	state.OspfSettings = routingInterfaceOspfFromAPI(routingInterface.OspfSettings)
+}
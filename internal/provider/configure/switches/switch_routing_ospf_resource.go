@@ -0,0 +1,376 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &switchRoutingOspfResource{}
+	_ resource.ResourceWithConfigure      = &switchRoutingOspfResource{}
+	_ resource.ResourceWithImportState    = &switchRoutingOspfResource{}
+	_ resource.ResourceWithValidateConfig = &switchRoutingOspfResource{}
+)
+
+func NewSwitchRoutingOspfResource() resource.Resource {
+	return &switchRoutingOspfResource{}
+}
+
+type switchRoutingOspfResource struct {
+	client meraki.Client
+}
+
+type SwitchRoutingOspfResourceModel struct {
+	ID                       types.String               `tfsdk:"id"`
+	NetworkID                types.String               `tfsdk:"network_id"`
+	Enabled                  types.Bool                 `tfsdk:"enabled"`
+	HelloTimerInSeconds      types.Int64                `tfsdk:"hello_timer_in_seconds"`
+	DeadTimerInSeconds       types.Int64                `tfsdk:"dead_timer_in_seconds"`
+	Areas                    []SwitchOspfAreaModel      `tfsdk:"areas"`
+	Md5AuthenticationEnabled types.Bool                 `tfsdk:"md5_authentication_enabled"`
+	Md5AuthenticationKey     *SwitchOspfMd5AuthKeyModel `tfsdk:"md5_authentication_key"`
+}
+
+type SwitchOspfAreaModel struct {
+	AreaID   types.String `tfsdk:"area_id"`
+	AreaName types.String `tfsdk:"area_name"`
+	AreaType types.String `tfsdk:"area_type"`
+}
+
+type SwitchOspfMd5AuthKeyModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Passphrase types.String `tfsdk:"passphrase"`
+}
+
+func (s *switchRoutingOspfResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_routing_ospf"
+}
+
+func (s *switchRoutingOspfResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the layer 3 OSPF settings of the switches of a network. OSPF is disabled on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether OSPF is enabled",
+			},
+			"hello_timer_in_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The interval between hello packets, between 1 and 255 seconds. Defaults to 10",
+				Default:     int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+			},
+			"dead_timer_in_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The time after which a neighbor without hello packets is considered down, between 1 and 65535 seconds. Defaults to 40",
+				Default:     int64default.StaticInt64(40),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"areas": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The OSPF areas, the areas of the network are left untouched when not set",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"area_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the area",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(ospfAreaRegexp, "must be an OSPF area ID"),
+							},
+						},
+						"area_name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the area",
+						},
+						"area_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of the area, can be 'normal', 'stub' or 'nssa'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("normal", "stub", "nssa"),
+							},
+						},
+					},
+				},
+			},
+			"md5_authentication_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether OSPF packets are authenticated with MD5. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"md5_authentication_key": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The MD5 authentication key, required when md5_authentication_enabled is true",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Required:    true,
+						Description: "The ID of the key, between 1 and 255",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 255),
+						},
+					},
+					"passphrase": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The passphrase of the key, at most 16 characters",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 16),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *switchRoutingOspfResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch routing OSPF resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch routing OSPF resource")
+}
+
+func (s *switchRoutingOspfResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchRoutingOspfResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Md5AuthenticationEnabled.ValueBool() && config.Md5AuthenticationKey == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("md5_authentication_key"),
+			"Missing MD5 authentication key",
+			"md5_authentication_key is required when md5_authentication_enabled is true",
+		)
+	}
+
+	if !config.HelloTimerInSeconds.IsUnknown() && !config.DeadTimerInSeconds.IsUnknown() &&
+		!config.HelloTimerInSeconds.IsNull() && !config.DeadTimerInSeconds.IsNull() &&
+		config.DeadTimerInSeconds.ValueInt64() <= config.HelloTimerInSeconds.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dead_timer_in_seconds"),
+			"Invalid OSPF timers",
+			"dead_timer_in_seconds must be greater than hello_timer_in_seconds",
+		)
+	}
+
+	seen := map[string]bool{}
+	for i, area := range config.Areas {
+		if area.AreaID.IsUnknown() {
+			continue
+		}
+		if seen[area.AreaID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("areas").AtListIndex(i).AtName("area_id"),
+				"Duplicate OSPF area",
+				fmt.Sprintf("area %s is configured more than once", area.AreaID.ValueString()),
+			)
+		}
+		seen[area.AreaID.ValueString()] = true
+	}
+}
+
+func (s *switchRoutingOspfResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch routing OSPF resource")
+	var plan SwitchRoutingOspfResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.UpdateSwitchOspfSettings(plan.NetworkID.ValueString(), switchOspfToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch OSPF settings", "Failed to update switch OSPF settings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	switchOspfFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch routing OSPF resource")
+}
+
+func (s *switchRoutingOspfResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch routing OSPF resource")
+	var state SwitchRoutingOspfResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.GetSwitchOspfSettings(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch OSPF settings", "Failed to get switch OSPF settings: "+err.Error())
+		return
+	}
+
+	switchOspfFromAPI(settings, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch routing OSPF resource")
+}
+
+func (s *switchRoutingOspfResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch routing OSPF resource")
+	var plan SwitchRoutingOspfResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.UpdateSwitchOspfSettings(plan.NetworkID.ValueString(), switchOspfToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch OSPF settings", "Failed to update switch OSPF settings: "+err.Error())
+		return
+	}
+
+	switchOspfFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch routing OSPF resource")
+}
+
+func (s *switchRoutingOspfResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch routing OSPF resource")
+	var state SwitchRoutingOspfResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.UpdateSwitchOspfSettings(state.NetworkID.ValueString(), &meraki.SwitchOspfSettings{
+		Enabled:             false,
+		HelloTimerInSeconds: 10,
+		DeadTimerInSeconds:  40,
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch OSPF settings", "Failed to delete switch OSPF settings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch routing OSPF resource")
+}
+
+func (s *switchRoutingOspfResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func switchOspfToAPI(plan *SwitchRoutingOspfResourceModel) *meraki.SwitchOspfSettings {
+	settings := &meraki.SwitchOspfSettings{
+		Enabled:                  plan.Enabled.ValueBool(),
+		HelloTimerInSeconds:      plan.HelloTimerInSeconds.ValueInt64(),
+		DeadTimerInSeconds:       plan.DeadTimerInSeconds.ValueInt64(),
+		Md5AuthenticationEnabled: plan.Md5AuthenticationEnabled.ValueBool(),
+	}
+	for _, area := range plan.Areas {
+		settings.Areas = append(settings.Areas, meraki.SwitchOspfArea{
+			AreaID:   meraki.FlexibleID(area.AreaID.ValueString()),
+			AreaName: area.AreaName.ValueString(),
+			AreaType: area.AreaType.ValueString(),
+		})
+	}
+	if plan.Md5AuthenticationKey != nil {
+		settings.Md5AuthenticationKey = &meraki.SwitchOspfMd5AuthenticationKey{
+			ID:         plan.Md5AuthenticationKey.ID.ValueInt64(),
+			Passphrase: plan.Md5AuthenticationKey.Passphrase.ValueString(),
+		}
+	}
+	return settings
+}
+
+// switchOspfFromAPI updates the state from the API. The areas are only tracked when configured, the
+// MD5 key is only read while MD5 authentication is enabled and the passphrase, which the API does not
+// always return, is kept from the prior state.
+func switchOspfFromAPI(settings *meraki.SwitchOspfSettings, state *SwitchRoutingOspfResourceModel) {
+	state.Enabled = types.BoolValue(settings.Enabled)
+	state.HelloTimerInSeconds = types.Int64Value(settings.HelloTimerInSeconds)
+	state.DeadTimerInSeconds = types.Int64Value(settings.DeadTimerInSeconds)
+	state.Md5AuthenticationEnabled = types.BoolValue(settings.Md5AuthenticationEnabled)
+
+	if state.Areas != nil {
+		state.Areas = make([]SwitchOspfAreaModel, 0, len(settings.Areas))
+		for _, area := range settings.Areas {
+			state.Areas = append(state.Areas, SwitchOspfAreaModel{
+				AreaID:   types.StringValue(string(area.AreaID)),
+				AreaName: types.StringValue(area.AreaName),
+				AreaType: types.StringValue(area.AreaType),
+			})
+		}
+	}
+
+	if !settings.Md5AuthenticationEnabled || settings.Md5AuthenticationKey == nil {
+		return
+	}
+	key := &SwitchOspfMd5AuthKeyModel{
+		ID:         types.Int64Value(settings.Md5AuthenticationKey.ID),
+		Passphrase: types.StringValue(settings.Md5AuthenticationKey.Passphrase),
+	}
+	if settings.Md5AuthenticationKey.Passphrase == "" && state.Md5AuthenticationKey != nil {
+		key.Passphrase = state.Md5AuthenticationKey.Passphrase
+	}
+	state.Md5AuthenticationKey = key
+}
@@ -0,0 +1,275 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &switchSettingsResource{}
+	_ resource.ResourceWithConfigure      = &switchSettingsResource{}
+	_ resource.ResourceWithImportState    = &switchSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &switchSettingsResource{}
+)
+
+func NewSwitchSettingsResource() resource.Resource {
+	return &switchSettingsResource{}
+}
+
+type switchSettingsResource struct {
+	client meraki.Client
+}
+
+type SwitchSettingsResourceModel struct {
+	ID               types.String                `tfsdk:"id"`
+	NetworkID        types.String                `tfsdk:"network_id"`
+	Vlan             types.Int64                 `tfsdk:"vlan"`
+	UseCombinedPower types.Bool                  `tfsdk:"use_combined_power"`
+	PowerExceptions  []SwitchPowerExceptionModel `tfsdk:"power_exceptions"`
+}
+
+type SwitchPowerExceptionModel struct {
+	Serial    types.String `tfsdk:"serial"`
+	PowerType types.String `tfsdk:"power_type"`
+}
+
+func (s *switchSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_settings"
+}
+
+func (s *switchSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the general settings of the switches of a network. The defaults are restored on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlan": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The management VLAN of the switches. Defaults to 1",
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"use_combined_power": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether switches with two power supplies use them as combined power instead of redundant power. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"power_exceptions": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The switches that do not use the power setting of the network",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"serial": schema.StringAttribute{
+							Required:    true,
+							Description: "The serial number of the switch",
+						},
+						"power_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The power mode of the switch, can be 'combined', 'redundant' or 'useNetworkSetting'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("combined", "redundant", "useNetworkSetting"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *switchSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch settings resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch settings resource")
+}
+
+func (s *switchSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, exception := range config.PowerExceptions {
+		if exception.Serial.IsUnknown() {
+			continue
+		}
+		if seen[exception.Serial.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("power_exceptions").AtListIndex(i).AtName("serial"),
+				"Duplicate power exception",
+				fmt.Sprintf("switch %s has more than one power exception", exception.Serial.ValueString()),
+			)
+		}
+		seen[exception.Serial.ValueString()] = true
+	}
+}
+
+func (s *switchSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch settings resource")
+	var plan SwitchSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.UpdateSwitchSettings(plan.NetworkID.ValueString(), switchSettingsToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch settings", "Failed to update switch settings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	switchSettingsFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch settings resource")
+}
+
+func (s *switchSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch settings resource")
+	var state SwitchSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.GetSwitchSettings(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch settings", "Failed to get switch settings: "+err.Error())
+		return
+	}
+
+	switchSettingsFromAPI(settings, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch settings resource")
+}
+
+func (s *switchSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch settings resource")
+	var plan SwitchSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.UpdateSwitchSettings(plan.NetworkID.ValueString(), switchSettingsToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch settings", "Failed to update switch settings: "+err.Error())
+		return
+	}
+
+	switchSettingsFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch settings resource")
+}
+
+func (s *switchSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch settings resource")
+	var state SwitchSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.UpdateSwitchSettings(state.NetworkID.ValueString(), &meraki.SwitchSettings{
+		Vlan:             1,
+		UseCombinedPower: false,
+		PowerExceptions:  []meraki.SwitchPowerException{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch settings", "Failed to delete switch settings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch settings resource")
+}
+
+func (s *switchSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func switchSettingsToAPI(plan *SwitchSettingsResourceModel) *meraki.SwitchSettings {
+	settings := &meraki.SwitchSettings{
+		Vlan:             plan.Vlan.ValueInt64(),
+		UseCombinedPower: plan.UseCombinedPower.ValueBool(),
+		PowerExceptions:  make([]meraki.SwitchPowerException, 0, len(plan.PowerExceptions)),
+	}
+	for _, exception := range plan.PowerExceptions {
+		settings.PowerExceptions = append(settings.PowerExceptions, meraki.SwitchPowerException{
+			Serial:    exception.Serial.ValueString(),
+			PowerType: exception.PowerType.ValueString(),
+		})
+	}
+	return settings
+}
+
+func switchSettingsFromAPI(settings *meraki.SwitchSettings, state *SwitchSettingsResourceModel) {
+	state.Vlan = types.Int64Value(settings.Vlan)
+	state.UseCombinedPower = types.BoolValue(settings.UseCombinedPower)
+	state.PowerExceptions = nil
+	for _, exception := range settings.PowerExceptions {
+		state.PowerExceptions = append(state.PowerExceptions, SwitchPowerExceptionModel{
+			Serial:    types.StringValue(exception.Serial),
+			PowerType: types.StringValue(exception.PowerType),
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+)
+
+var (
+	_ resource.Resource                = &switchStackResource{}
+	_ resource.ResourceWithConfigure   = &switchStackResource{}
+	_ resource.ResourceWithImportState = &switchStackResource{}
+	_ resource.ResourceWithModifyPlan  = &switchStackResource{}
+)
+
+func NewSwitchStackResource() resource.Resource {
+	return &switchStackResource{}
+}
+
+type switchStackResource struct {
+	client meraki.Client
+}
+
+type SwitchStackResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	NetworkID types.String `tfsdk:"network_id"`
+	StackID   types.String `tfsdk:"stack_id"`
+	Name      types.String `tfsdk:"name"`
+	Serials   types.Set    `tfsdk:"serials"`
+}
+
+func (s *switchStackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_stack"
+}
+
+func (s *switchStackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a stack of MS switches. Members are added and removed in place, the stack is deleted on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/stack_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network the stack belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stack_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the stack",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the stack. Stacks can not be renamed, so changing it replaces the stack",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"serials": schema.SetAttribute{
+				Required:    true,
+				Description: "The serials of the member switches, which must be claimed in the network of the stack",
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(2),
+				},
+			},
+		},
+	}
+}
+
+func (s *switchStackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch stack resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch stack resource")
+}
+
+// ModifyPlan checks that all member switches are claimed in the network of the stack whenever the
+// members change, so that a misplaced serial fails the plan instead of leaving a partial stack.
+func (s *switchStackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || s.client == nil {
+		return
+	}
+
+	var plan SwitchStackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.NetworkID.IsUnknown() || plan.Serials.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state SwitchStackResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.NetworkID.Equal(plan.NetworkID) && state.Serials.Equal(plan.Serials) {
+			return
+		}
+	}
+
+	var serials []string
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, plan.Serials, &serials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(s.validateMembers(plan.NetworkID.ValueString(), serials)...)
+}
+
+func (s *switchStackResource) validateMembers(networkID string, serials []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	network, err := s.client.GetNetwork(networkID)
+	if err != nil {
+		diags.AddError("Failed to get network", "Failed to get network: "+err.Error())
+		return diags
+	}
+	devices, err := s.client.GetOrganizationDevices(network.OrgID, &meraki.DevicesFilter{Serials: serials})
+	if err != nil {
+		diags.AddError("Failed to get organization devices", "Failed to get organization devices: "+err.Error())
+		return diags
+	}
+
+	networks := make(map[string]string, len(devices))
+	for _, device := range devices {
+		networks[device.Serial] = device.NetworkID
+	}
+	for _, serial := range serials {
+		if networks[serial] != networkID {
+			diags.AddAttributeError(
+				path.Root("serials"),
+				"Invalid switch stack member",
+				fmt.Sprintf("switch %s is not claimed in network %s", serial, networkID),
+			)
+		}
+	}
+	return diags
+}
+
+func (s *switchStackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch stack resource")
+	var plan SwitchStackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var serials []string
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, plan.Serials, &serials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(serials)
+
+	stack, err := s.client.CreateSwitchStack(plan.NetworkID.ValueString(), &meraki.SwitchStack{
+		Name:    plan.Name.ValueString(),
+		Serials: serials,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create switch stack", "Failed to create switch stack: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + stack.ID)
+	plan.StackID = types.StringValue(stack.ID)
+	resp.Diagnostics.Append(switchStackFromAPI(ctx, stack, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch stack resource")
+}
+
+func (s *switchStackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch stack resource")
+	var state SwitchStackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stack, err := s.client.GetSwitchStack(state.NetworkID.ValueString(), state.StackID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch stack", "Failed to get switch stack: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(switchStackFromAPI(ctx, stack, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch stack resource")
+}
+
+func (s *switchStackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch stack resource")
+	var plan, state SwitchStackResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planSerials, stateSerials []string
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, plan.Serials, &planSerials)...)
+	resp.Diagnostics.Append(helpers.SetToStrings(ctx, state.Serials, &stateSerials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID, stackID := state.NetworkID.ValueString(), state.StackID.ValueString()
+	added := helpers.Difference(planSerials, stateSerials)
+	removed := helpers.Difference(stateSerials, planSerials)
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	// new members are added first, so the stack never drops below two members
+	for _, serial := range added {
+		tflog.Debug(ctx, "Adding switch stack member", map[string]interface{}{"serial": serial})
+		_, err := s.client.AddSwitchStackMember(networkID, stackID, serial)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to add switch stack member", "Failed to add switch stack member: "+err.Error())
+			return
+		}
+	}
+	for _, serial := range removed {
+		tflog.Debug(ctx, "Removing switch stack member", map[string]interface{}{"serial": serial})
+		_, err := s.client.RemoveSwitchStackMember(networkID, stackID, serial)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to remove switch stack member", "Failed to remove switch stack member: "+err.Error())
+			return
+		}
+	}
+
+	stack, err := s.client.GetSwitchStack(networkID, stackID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch stack", "Failed to get switch stack: "+err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.StackID = state.StackID
+	resp.Diagnostics.Append(switchStackFromAPI(ctx, stack, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch stack resource")
+}
+
+func (s *switchStackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch stack resource")
+	var state SwitchStackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.DeleteSwitchStack(state.NetworkID.ValueString(), state.StackID.ValueString())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch stack", "Failed to delete switch stack: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch stack resource")
+}
+
+func (s *switchStackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, stackID, err := helpers.SplitImportID(req.ID, "network_id/stack_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("stack_id"), stackID)...)
+}
+
+func switchStackFromAPI(ctx context.Context, stack *meraki.SwitchStack, state *SwitchStackResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.Name = types.StringValue(stack.Name)
+	state.Serials, diags = types.SetValueFrom(ctx, types.StringType, stack.Serials)
+	return diags
+}
@@ -0,0 +1,284 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &switchStackRoutingInterfaceResource{}
+	_ resource.ResourceWithConfigure      = &switchStackRoutingInterfaceResource{}
+	_ resource.ResourceWithImportState    = &switchStackRoutingInterfaceResource{}
+	_ resource.ResourceWithValidateConfig = &switchStackRoutingInterfaceResource{}
+)
+
+func NewSwitchStackRoutingInterfaceResource() resource.Resource {
+	return &switchStackRoutingInterfaceResource{}
+}
+
+type switchStackRoutingInterfaceResource struct {
+	client meraki.Client
+}
+
+type SwitchStackRoutingInterfaceResourceModel struct {
+	ID               types.String                       `tfsdk:"id"`
+	NetworkID        types.String                       `tfsdk:"network_id"`
+	SwitchStackID    types.String                       `tfsdk:"switch_stack_id"`
+	InterfaceID      types.String                       `tfsdk:"interface_id"`
+	Name             types.String                       `tfsdk:"name"`
+	Subnet           types.String                       `tfsdk:"subnet"`
+	InterfaceIP      types.String                       `tfsdk:"interface_ip"`
+	VlanID           types.Int64                        `tfsdk:"vlan_id"`
+	DefaultGateway   types.String                       `tfsdk:"default_gateway"`
+	MulticastRouting types.String                       `tfsdk:"multicast_routing"`
+	OspfSettings     *RoutingInterfaceOspfSettingsModel `tfsdk:"ospf_settings"`
+}
+
+func (s *switchStackRoutingInterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_stack_routing_interface"
+}
+
+func (s *switchStackRoutingInterfaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a layer 3 interface of a switch stack.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/switch_stack_id/interface_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network the stack belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"switch_stack_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the switch stack",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the interface",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the interface",
+			},
+			"subnet": schema.StringAttribute{
+				Required:    true,
+				Description: "The network the interface is in, in CIDR notation",
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"interface_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address of the stack on the subnet",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The VLAN of the interface",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"default_gateway": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The next hop for traffic leaving the stack through this interface, only applicable and required for the first interface",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"multicast_routing": multicastRoutingAttribute(),
+			"ospf_settings":     routingInterfaceOspfAttribute(),
+		},
+	}
+}
+
+func (s *switchStackRoutingInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch stack routing interface resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch stack routing interface resource")
+}
+
+func (s *switchStackRoutingInterfaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchStackRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRoutingInterface(config.Subnet, config.InterfaceIP, config.DefaultGateway)...)
+}
+
+func (s *switchStackRoutingInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch stack routing interface resource")
+	var plan SwitchStackRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routingInterface, err := s.client.CreateSwitchStackRoutingInterface(plan.NetworkID.ValueString(), plan.SwitchStackID.ValueString(), stackRoutingInterfaceToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create switch stack routing interface", "Failed to create switch stack routing interface: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + plan.SwitchStackID.ValueString() + "/" + routingInterface.InterfaceID)
+	plan.InterfaceID = types.StringValue(routingInterface.InterfaceID)
+	stackRoutingInterfaceFromAPI(routingInterface, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch stack routing interface resource")
+}
+
+func (s *switchStackRoutingInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch stack routing interface resource")
+	var state SwitchStackRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routingInterface, err := s.client.GetSwitchStackRoutingInterface(state.NetworkID.ValueString(), state.SwitchStackID.ValueString(), state.InterfaceID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch stack routing interface", "Failed to get switch stack routing interface: "+err.Error())
+		return
+	}
+
+	stackRoutingInterfaceFromAPI(routingInterface, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch stack routing interface resource")
+}
+
+func (s *switchStackRoutingInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch stack routing interface resource")
+	var plan SwitchStackRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routingInterface, err := s.client.UpdateSwitchStackRoutingInterface(plan.NetworkID.ValueString(), plan.SwitchStackID.ValueString(), plan.InterfaceID.ValueString(), stackRoutingInterfaceToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch stack routing interface", "Failed to update switch stack routing interface: "+err.Error())
+		return
+	}
+
+	stackRoutingInterfaceFromAPI(routingInterface, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch stack routing interface resource")
+}
+
+func (s *switchStackRoutingInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch stack routing interface resource")
+	var state SwitchStackRoutingInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.DeleteSwitchStackRoutingInterface(state.NetworkID.ValueString(), state.SwitchStackID.ValueString(), state.InterfaceID.ValueString())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch stack routing interface", "Failed to delete switch stack routing interface: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch stack routing interface resource")
+}
+
+func (s *switchStackRoutingInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected import ID in the form network_id/switch_stack_id/interface_id, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("switch_stack_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_id"), parts[2])...)
+}
+
+func stackRoutingInterfaceToAPI(plan *SwitchStackRoutingInterfaceResourceModel) *meraki.SwitchRoutingInterface {
+	routingInterface := &meraki.SwitchRoutingInterface{
+		Name:        plan.Name.ValueString(),
+		Subnet:      plan.Subnet.ValueString(),
+		InterfaceIP: plan.InterfaceIP.ValueString(),
+		VlanID:      plan.VlanID.ValueInt64(),
+	}
+	if !plan.DefaultGateway.IsUnknown() {
+		routingInterface.DefaultGateway = plan.DefaultGateway.ValueString()
+	}
+	routingInterface.MulticastRouting = plan.MulticastRouting.ValueString()
+	routingInterface.OspfSettings = routingInterfaceOspfToAPI(plan.OspfSettings)
+	return routingInterface
+}
+
+func stackRoutingInterfaceFromAPI(routingInterface *meraki.SwitchRoutingInterface, state *SwitchStackRoutingInterfaceResourceModel) {
+	state.Name = types.StringValue(routingInterface.Name)
+	state.Subnet = types.StringValue(routingInterface.Subnet)
+	state.InterfaceIP = types.StringValue(routingInterface.InterfaceIP)
+	state.VlanID = types.Int64Value(routingInterface.VlanID)
+	state.DefaultGateway = helpers.StringValueOrNull(routingInterface.DefaultGateway)
+	state.MulticastRouting = types.StringValue(routingInterface.MulticastRouting)
+	state.OspfSettings = routingInterfaceOspfFromAPI(routingInterface.OspfSettings)
+}
@@ -0,0 +1,267 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &switchStackStaticRouteResource{}
+	_ resource.ResourceWithConfigure      = &switchStackStaticRouteResource{}
+	_ resource.ResourceWithImportState    = &switchStackStaticRouteResource{}
+	_ resource.ResourceWithValidateConfig = &switchStackStaticRouteResource{}
+)
+
+func NewSwitchStackStaticRouteResource() resource.Resource {
+	return &switchStackStaticRouteResource{}
+}
+
+type switchStackStaticRouteResource struct {
+	client meraki.Client
+}
+
+type SwitchStackStaticRouteResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	NetworkID                   types.String `tfsdk:"network_id"`
+	SwitchStackID               types.String `tfsdk:"switch_stack_id"`
+	StaticRouteID               types.String `tfsdk:"static_route_id"`
+	Name                        types.String `tfsdk:"name"`
+	Subnet                      types.String `tfsdk:"subnet"`
+	NextHopIP                   types.String `tfsdk:"next_hop_ip"`
+	AdvertiseViaOspfEnabled     types.Bool   `tfsdk:"advertise_via_ospf_enabled"`
+	PreferOverOspfRoutesEnabled types.Bool   `tfsdk:"prefer_over_ospf_routes_enabled"`
+}
+
+func (s *switchStackStaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_stack_static_route"
+}
+
+func (s *switchStackStaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a layer 3 static route of a switch stack.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/switch_stack_id/static_route_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network the stack belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"switch_stack_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the switch stack",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"static_route_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the static route",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the static route",
+			},
+			"subnet": schema.StringAttribute{
+				Required:    true,
+				Description: "The destination subnet of the static route, in CIDR notation",
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"next_hop_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address of the next hop device, which must be reachable through a routing interface",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"advertise_via_ospf_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the route is redistributed into OSPF. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"prefer_over_ospf_routes_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the route is preferred over routes learned through OSPF. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (s *switchStackStaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch stack static route resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch stack static route resource")
+}
+
+func (s *switchStackStaticRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchStackStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateStaticRoute(config.Subnet, config.NextHopIP)...)
+}
+
+func (s *switchStackStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch stack static route resource")
+	var plan SwitchStackStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := s.client.CreateSwitchStackStaticRoute(plan.NetworkID.ValueString(), plan.SwitchStackID.ValueString(), stackStaticRouteToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create switch stack static route", "Failed to create switch stack static route: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + plan.SwitchStackID.ValueString() + "/" + route.StaticRouteID)
+	plan.StaticRouteID = types.StringValue(route.StaticRouteID)
+	stackStaticRouteFromAPI(route, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch stack static route resource")
+}
+
+func (s *switchStackStaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch stack static route resource")
+	var state SwitchStackStaticRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := s.client.GetSwitchStackStaticRoute(state.NetworkID.ValueString(), state.SwitchStackID.ValueString(), state.StaticRouteID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch stack static route", "Failed to get switch stack static route: "+err.Error())
+		return
+	}
+
+	stackStaticRouteFromAPI(route, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch stack static route resource")
+}
+
+func (s *switchStackStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch stack static route resource")
+	var plan SwitchStackStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := s.client.UpdateSwitchStackStaticRoute(plan.NetworkID.ValueString(), plan.SwitchStackID.ValueString(), plan.StaticRouteID.ValueString(), stackStaticRouteToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch stack static route", "Failed to update switch stack static route: "+err.Error())
+		return
+	}
+
+	stackStaticRouteFromAPI(route, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch stack static route resource")
+}
+
+func (s *switchStackStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch stack static route resource")
+	var state SwitchStackStaticRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.DeleteSwitchStackStaticRoute(state.NetworkID.ValueString(), state.SwitchStackID.ValueString(), state.StaticRouteID.ValueString())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch stack static route", "Failed to delete switch stack static route: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch stack static route resource")
+}
+
+func (s *switchStackStaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected import ID in the form network_id/switch_stack_id/static_route_id, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("switch_stack_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_route_id"), parts[2])...)
+}
+
+func stackStaticRouteToAPI(plan *SwitchStackStaticRouteResourceModel) *meraki.SwitchStaticRoute {
+	return &meraki.SwitchStaticRoute{
+		Name:                        plan.Name.ValueString(),
+		Subnet:                      plan.Subnet.ValueString(),
+		NextHopIP:                   plan.NextHopIP.ValueString(),
+		AdvertiseViaOspfEnabled:     plan.AdvertiseViaOspfEnabled.ValueBool(),
+		PreferOverOspfRoutesEnabled: plan.PreferOverOspfRoutesEnabled.ValueBool(),
+	}
+}
+
+func stackStaticRouteFromAPI(route *meraki.SwitchStaticRoute, state *SwitchStackStaticRouteResourceModel) {
+	state.Name = helpers.StringValueOrNull(route.Name)
+	state.Subnet = types.StringValue(route.Subnet)
+	state.NextHopIP = types.StringValue(route.NextHopIP)
+	state.AdvertiseViaOspfEnabled = types.BoolValue(route.AdvertiseViaOspfEnabled)
+	state.PreferOverOspfRoutesEnabled = types.BoolValue(route.PreferOverOspfRoutesEnabled)
+}
@@ -0,0 +1,257 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &switchStaticRouteResource{}
+	_ resource.ResourceWithConfigure      = &switchStaticRouteResource{}
+	_ resource.ResourceWithImportState    = &switchStaticRouteResource{}
+	_ resource.ResourceWithValidateConfig = &switchStaticRouteResource{}
+)
+
+func NewSwitchStaticRouteResource() resource.Resource {
+	return &switchStaticRouteResource{}
+}
+
+type switchStaticRouteResource struct {
+	client meraki.Client
+}
+
+type SwitchStaticRouteResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Serial                      types.String `tfsdk:"serial"`
+	StaticRouteID               types.String `tfsdk:"static_route_id"`
+	Name                        types.String `tfsdk:"name"`
+	Subnet                      types.String `tfsdk:"subnet"`
+	NextHopIP                   types.String `tfsdk:"next_hop_ip"`
+	AdvertiseViaOspfEnabled     types.Bool   `tfsdk:"advertise_via_ospf_enabled"`
+	PreferOverOspfRoutesEnabled types.Bool   `tfsdk:"prefer_over_ospf_routes_enabled"`
+}
+
+func (s *switchStaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_static_route"
+}
+
+func (s *switchStaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a layer 3 static route of an MS switch.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form serial/static_route_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				Required:    true,
+				Description: "The serial number of the switch",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"static_route_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the static route",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the static route",
+			},
+			"subnet": schema.StringAttribute{
+				Required:    true,
+				Description: "The destination subnet of the static route, in CIDR notation",
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
+			},
+			"next_hop_ip": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address of the next hop device, which must be reachable through a routing interface",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
+			},
+			"advertise_via_ospf_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the route is redistributed into OSPF. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"prefer_over_ospf_routes_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the route is preferred over routes learned through OSPF. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (s *switchStaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch static route resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch static route resource")
+}
+
+func (s *switchStaticRouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateStaticRoute(config.Subnet, config.NextHopIP)...)
+}
+
+func (s *switchStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch static route resource")
+	var plan SwitchStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := s.client.CreateSwitchStaticRoute(plan.Serial.ValueString(), staticRouteToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create switch static route", "Failed to create switch static route: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Serial.ValueString() + "/" + route.StaticRouteID)
+	plan.StaticRouteID = types.StringValue(route.StaticRouteID)
+	staticRouteFromAPI(route, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch static route resource")
+}
+
+func (s *switchStaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch static route resource")
+	var state SwitchStaticRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := s.client.GetSwitchStaticRoute(state.Serial.ValueString(), state.StaticRouteID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch static route", "Failed to get switch static route: "+err.Error())
+		return
+	}
+
+	staticRouteFromAPI(route, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch static route resource")
+}
+
+func (s *switchStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch static route resource")
+	var plan SwitchStaticRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := s.client.UpdateSwitchStaticRoute(plan.Serial.ValueString(), plan.StaticRouteID.ValueString(), staticRouteToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch static route", "Failed to update switch static route: "+err.Error())
+		return
+	}
+
+	staticRouteFromAPI(route, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch static route resource")
+}
+
+func (s *switchStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch static route resource")
+	var state SwitchStaticRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.client.DeleteSwitchStaticRoute(state.Serial.ValueString(), state.StaticRouteID.ValueString())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch static route", "Failed to delete switch static route: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch static route resource")
+}
+
+func (s *switchStaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serial, routeID, err := helpers.SplitImportID(req.ID, "serial/static_route_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), serial)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("static_route_id"), routeID)...)
+}
+
+func staticRouteToAPI(plan *SwitchStaticRouteResourceModel) *meraki.SwitchStaticRoute {
+	return &meraki.SwitchStaticRoute{
+		Name:                        plan.Name.ValueString(),
+		Subnet:                      plan.Subnet.ValueString(),
+		NextHopIP:                   plan.NextHopIP.ValueString(),
+		AdvertiseViaOspfEnabled:     plan.AdvertiseViaOspfEnabled.ValueBool(),
+		PreferOverOspfRoutesEnabled: plan.PreferOverOspfRoutesEnabled.ValueBool(),
+	}
+}
+
+func staticRouteFromAPI(route *meraki.SwitchStaticRoute, state *SwitchStaticRouteResourceModel) {
+	state.Name = helpers.StringValueOrNull(route.Name)
+	state.Subnet = types.StringValue(route.Subnet)
+	state.NextHopIP = types.StringValue(route.NextHopIP)
+	state.AdvertiseViaOspfEnabled = types.BoolValue(route.AdvertiseViaOspfEnabled)
+	state.PreferOverOspfRoutesEnabled = types.BoolValue(route.PreferOverOspfRoutesEnabled)
+}
@@ -0,0 +1,227 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &switchStormControlResource{}
+	_ resource.ResourceWithConfigure   = &switchStormControlResource{}
+	_ resource.ResourceWithImportState = &switchStormControlResource{}
+)
+
+// stormControlDisabled is the threshold at which storm control is disabled for a traffic type.
+const stormControlDisabled = 100
+
+func NewSwitchStormControlResource() resource.Resource {
+	return &switchStormControlResource{}
+}
+
+type switchStormControlResource struct {
+	client meraki.Client
+}
+
+type SwitchStormControlResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	NetworkID               types.String `tfsdk:"network_id"`
+	BroadcastThreshold      types.Int64  `tfsdk:"broadcast_threshold"`
+	MulticastThreshold      types.Int64  `tfsdk:"multicast_threshold"`
+	UnknownUnicastThreshold types.Int64  `tfsdk:"unknown_unicast_threshold"`
+}
+
+func (s *switchStormControlResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_storm_control"
+}
+
+// stormControlThresholdAttribute returns a storm control threshold, which disables storm control
+// for the traffic type when not set.
+func stormControlThresholdAttribute(traffic string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("The share of the link speed %s traffic may use, between 1 and 100 percent. Defaults to 100, which disables storm control for %s traffic", traffic, traffic),
+		Default:     int64default.StaticInt64(stormControlDisabled),
+		Validators: []validator.Int64{
+			int64validator.Between(1, stormControlDisabled),
+		},
+	}
+}
+
+func (s *switchStormControlResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the storm control thresholds of the switches of a network. Storm control is disabled on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"broadcast_threshold":       stormControlThresholdAttribute("broadcast"),
+			"multicast_threshold":       stormControlThresholdAttribute("multicast"),
+			"unknown_unicast_threshold": stormControlThresholdAttribute("unknown unicast"),
+		},
+	}
+}
+
+func (s *switchStormControlResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch storm control resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch storm control resource")
+}
+
+func (s *switchStormControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch storm control resource")
+	var plan SwitchStormControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stormControl, err := s.client.UpdateSwitchStormControl(plan.NetworkID.ValueString(), switchStormControlToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch storm control", "Failed to update switch storm control: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	switchStormControlFromAPI(stormControl, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch storm control resource")
+}
+
+func (s *switchStormControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch storm control resource")
+	var state SwitchStormControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stormControl, err := s.client.GetSwitchStormControl(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch storm control", "Failed to get switch storm control: "+err.Error())
+		return
+	}
+
+	switchStormControlFromAPI(stormControl, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch storm control resource")
+}
+
+func (s *switchStormControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch storm control resource")
+	var plan SwitchStormControlResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stormControl, err := s.client.UpdateSwitchStormControl(plan.NetworkID.ValueString(), switchStormControlToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch storm control", "Failed to update switch storm control: "+err.Error())
+		return
+	}
+
+	switchStormControlFromAPI(stormControl, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch storm control resource")
+}
+
+func (s *switchStormControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch storm control resource")
+	var state SwitchStormControlResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disabled := int64(stormControlDisabled)
+	_, err := s.client.UpdateSwitchStormControl(state.NetworkID.ValueString(), &meraki.SwitchStormControl{
+		BroadcastThreshold:      &disabled,
+		MulticastThreshold:      &disabled,
+		UnknownUnicastThreshold: &disabled,
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch storm control", "Failed to delete switch storm control: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch storm control resource")
+}
+
+func (s *switchStormControlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func switchStormControlToAPI(plan *SwitchStormControlResourceModel) *meraki.SwitchStormControl {
+	return &meraki.SwitchStormControl{
+		BroadcastThreshold:      helpers.Int64PointerOrNil(plan.BroadcastThreshold),
+		MulticastThreshold:      helpers.Int64PointerOrNil(plan.MulticastThreshold),
+		UnknownUnicastThreshold: helpers.Int64PointerOrNil(plan.UnknownUnicastThreshold),
+	}
+}
+
+// stormControlThreshold maps the missing thresholds of traffic types without storm control to 100.
+func stormControlThreshold(threshold *int64) types.Int64 {
+	if threshold == nil {
+		return types.Int64Value(stormControlDisabled)
+	}
+	return types.Int64Value(*threshold)
+}
+
+func switchStormControlFromAPI(stormControl *meraki.SwitchStormControl, state *SwitchStormControlResourceModel) {
+	state.BroadcastThreshold = stormControlThreshold(stormControl.BroadcastThreshold)
+	state.MulticastThreshold = stormControlThreshold(stormControl.MulticastThreshold)
+	state.UnknownUnicastThreshold = stormControlThreshold(stormControl.UnknownUnicastThreshold)
+}
@@ -0,0 +1,279 @@
+package switches
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &switchStpResource{}
+	_ resource.ResourceWithConfigure      = &switchStpResource{}
+	_ resource.ResourceWithImportState    = &switchStpResource{}
+	_ resource.ResourceWithValidateConfig = &switchStpResource{}
+)
+
+func NewSwitchStpResource() resource.Resource {
+	return &switchStpResource{}
+}
+
+type switchStpResource struct {
+	client meraki.Client
+}
+
+type SwitchStpResourceModel struct {
+	ID                types.String                   `tfsdk:"id"`
+	NetworkID         types.String                   `tfsdk:"network_id"`
+	RstpEnabled       types.Bool                     `tfsdk:"rstp_enabled"`
+	StpBridgePriority []SwitchStpBridgePriorityModel `tfsdk:"stp_bridge_priority"`
+}
+
+type SwitchStpBridgePriorityModel struct {
+	Switches       []types.String `tfsdk:"switches"`
+	Stacks         []types.String `tfsdk:"stacks"`
+	SwitchProfiles []types.String `tfsdk:"switch_profiles"`
+	StpPriority    types.Int64    `tfsdk:"stp_priority"`
+}
+
+func (s *switchStpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switch_stp"
+}
+
+func (s *switchStpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// bridge priorities are set in increments of 4096
+	priorities := make([]int64, 0, 16)
+	for priority := int64(0); priority <= 61440; priority += 4096 {
+		priorities = append(priorities, priority)
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the spanning tree settings of the switches of a network. The defaults are restored on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rstp_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the rapid spanning tree protocol is enabled. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"stp_bridge_priority": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The bridge priorities of switches, stacks and switch profiles, which use the default priority of 32768 when not listed",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"switches": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The serials of the switches",
+						},
+						"stacks": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the switch stacks",
+						},
+						"switch_profiles": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the switch profiles of a configuration template",
+						},
+						"stp_priority": schema.Int64Attribute{
+							Required:    true,
+							Description: "The bridge priority, between 0 and 61440 in increments of 4096",
+							Validators: []validator.Int64{
+								int64validator.OneOf(priorities...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *switchStpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the switch STP resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	s.client = client
+	tflog.Info(ctx, "Configured the switch STP resource")
+}
+
+func (s *switchStpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SwitchStpResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, priority := range config.StpBridgePriority {
+		if len(priority.Switches) == 0 && len(priority.Stacks) == 0 && len(priority.SwitchProfiles) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("stp_bridge_priority").AtListIndex(i),
+				"Invalid STP bridge priority",
+				"at least one of switches, stacks or switch_profiles must be set",
+			)
+		}
+	}
+}
+
+func (s *switchStpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the switch STP resource")
+	var plan SwitchStpResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.UpdateSwitchStpSettings(plan.NetworkID.ValueString(), switchStpToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch STP settings", "Failed to update switch STP settings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	switchStpFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the switch STP resource")
+}
+
+func (s *switchStpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the switch STP resource")
+	var state SwitchStpResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.GetSwitchStpSettings(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get switch STP settings", "Failed to get switch STP settings: "+err.Error())
+		return
+	}
+
+	switchStpFromAPI(settings, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the switch STP resource")
+}
+
+func (s *switchStpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the switch STP resource")
+	var plan SwitchStpResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := s.client.UpdateSwitchStpSettings(plan.NetworkID.ValueString(), switchStpToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update switch STP settings", "Failed to update switch STP settings: "+err.Error())
+		return
+	}
+
+	switchStpFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the switch STP resource")
+}
+
+func (s *switchStpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the switch STP resource")
+	var state SwitchStpResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.client.UpdateSwitchStpSettings(state.NetworkID.ValueString(), &meraki.SwitchStpSettings{
+		RstpEnabled:       true,
+		StpBridgePriority: []meraki.SwitchStpBridgePriority{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete switch STP settings", "Failed to delete switch STP settings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the switch STP resource")
+}
+
+func (s *switchStpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func switchStpToAPI(plan *SwitchStpResourceModel) *meraki.SwitchStpSettings {
+	settings := &meraki.SwitchStpSettings{
+		RstpEnabled:       plan.RstpEnabled.ValueBool(),
+		StpBridgePriority: make([]meraki.SwitchStpBridgePriority, 0, len(plan.StpBridgePriority)),
+	}
+	for _, priority := range plan.StpBridgePriority {
+		settings.StpBridgePriority = append(settings.StpBridgePriority, meraki.SwitchStpBridgePriority{
+			Switches:       helpers.FromStringValues(priority.Switches),
+			Stacks:         helpers.FromStringValues(priority.Stacks),
+			SwitchProfiles: helpers.FromStringValues(priority.SwitchProfiles),
+			StpPriority:    priority.StpPriority.ValueInt64(),
+		})
+	}
+	return settings
+}
+
+func switchStpFromAPI(settings *meraki.SwitchStpSettings, state *SwitchStpResourceModel) {
+	state.RstpEnabled = types.BoolValue(settings.RstpEnabled)
+	state.StpBridgePriority = nil
+	for _, priority := range settings.StpBridgePriority {
+		state.StpBridgePriority = append(state.StpBridgePriority, SwitchStpBridgePriorityModel{
+			Switches:       helpers.NilIfEmpty(helpers.ToStringValues(priority.Switches)),
+			Stacks:         helpers.NilIfEmpty(helpers.ToStringValues(priority.Stacks)),
+			SwitchProfiles: helpers.NilIfEmpty(helpers.ToStringValues(priority.SwitchProfiles)),
+			StpPriority:    types.Int64Value(priority.StpPriority),
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &wirelessBluetoothBeaconResource{}
+	_ resource.ResourceWithConfigure   = &wirelessBluetoothBeaconResource{}
+	_ resource.ResourceWithImportState = &wirelessBluetoothBeaconResource{}
+)
+
+func NewWirelessBluetoothBeaconResource() resource.Resource {
+	return &wirelessBluetoothBeaconResource{}
+}
+
+type wirelessBluetoothBeaconResource struct {
+	client meraki.Client
+}
+
+type WirelessBluetoothBeaconResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Serial types.String `tfsdk:"serial"`
+	UUID   types.String `tfsdk:"uuid"`
+	Major  types.Int64  `tfsdk:"major"`
+	Minor  types.Int64  `tfsdk:"minor"`
+}
+
+func (w *wirelessBluetoothBeaconResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_bluetooth_beacon"
+}
+
+// beaconNumberAttribute returns the optional iBeacon major or minor attribute, which the dashboard assigns when it is not set.
+func beaconNumberAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(0, 65535),
+		},
+	}
+}
+
+func (w *wirelessBluetoothBeaconResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the iBeacon identifiers advertised by an access point. The identifiers are kept on destroy, " +
+			"the resource is only removed from the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the serial",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial": schema.StringAttribute{
+				Required:    true,
+				Description: "The serial number of the access point",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uuid":  beaconUUIDAttribute("The iBeacon UUID advertised by the access point, the UUID of the network when not set"),
+			"major": beaconNumberAttribute("The iBeacon major advertised by the access point, assigned by the dashboard when not set"),
+			"minor": beaconNumberAttribute("The iBeacon minor advertised by the access point, assigned by the dashboard when not set"),
+		},
+	}
+}
+
+func (w *wirelessBluetoothBeaconResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless bluetooth beacon resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless bluetooth beacon resource")
+}
+
+func (w *wirelessBluetoothBeaconResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless bluetooth beacon resource")
+	var plan WirelessBluetoothBeaconResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.UpdateWirelessDeviceBluetoothSettings(plan.Serial.ValueString(), bluetoothBeaconToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless bluetooth beacon", "Failed to update wireless bluetooth beacon: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.Serial
+	bluetoothBeaconFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless bluetooth beacon resource")
+}
+
+func (w *wirelessBluetoothBeaconResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless bluetooth beacon resource")
+	var state WirelessBluetoothBeaconResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.GetWirelessDeviceBluetoothSettings(state.Serial.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless bluetooth beacon", "Failed to get wireless bluetooth beacon: "+err.Error())
+		return
+	}
+
+	bluetoothBeaconFromAPI(settings, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless bluetooth beacon resource")
+}
+
+func (w *wirelessBluetoothBeaconResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless bluetooth beacon resource")
+	var plan WirelessBluetoothBeaconResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.UpdateWirelessDeviceBluetoothSettings(plan.Serial.ValueString(), bluetoothBeaconToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless bluetooth beacon", "Failed to update wireless bluetooth beacon: "+err.Error())
+		return
+	}
+
+	bluetoothBeaconFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless bluetooth beacon resource")
+}
+
+func (w *wirelessBluetoothBeaconResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless bluetooth beacon resource")
+	// the identifiers of an access point can not be unset, so the beacon is only removed from the state
+	tflog.Info(ctx, "Deleted the wireless bluetooth beacon resource")
+}
+
+func (w *wirelessBluetoothBeaconResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial"), req.ID)...)
+}
+
+func bluetoothBeaconToAPI(plan *WirelessBluetoothBeaconResourceModel) *meraki.WirelessDeviceBluetoothSettings {
+	settings := &meraki.WirelessDeviceBluetoothSettings{}
+	if !plan.UUID.IsUnknown() {
+		settings.UUID = plan.UUID.ValueString()
+	}
+	if !plan.Major.IsNull() && !plan.Major.IsUnknown() {
+		major := plan.Major.ValueInt64()
+		settings.Major = &major
+	}
+	if !plan.Minor.IsNull() && !plan.Minor.IsUnknown() {
+		minor := plan.Minor.ValueInt64()
+		settings.Minor = &minor
+	}
+	return settings
+}
+
+func bluetoothBeaconFromAPI(settings *meraki.WirelessDeviceBluetoothSettings, state *WirelessBluetoothBeaconResourceModel) {
+	state.UUID = helpers.KeepCase(state.UUID, settings.UUID)
+	state.Major, state.Minor = types.Int64Null(), types.Int64Null()
+	if settings.Major != nil {
+		state.Major = types.Int64Value(*settings.Major)
+	}
+	if settings.Minor != nil {
+		state.Minor = types.Int64Value(*settings.Minor)
+	}
+}
@@ -0,0 +1,308 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+)
+
+var (
+	_ resource.Resource                   = &wirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithConfigure      = &wirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithImportState    = &wirelessBluetoothSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &wirelessBluetoothSettingsResource{}
+)
+
+var beaconUUIDRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func NewWirelessBluetoothSettingsResource() resource.Resource {
+	return &wirelessBluetoothSettingsResource{}
+}
+
+type wirelessBluetoothSettingsResource struct {
+	client meraki.Client
+}
+
+type WirelessBluetoothSettingsResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	NetworkID                types.String `tfsdk:"network_id"`
+	ScanningEnabled          types.Bool   `tfsdk:"scanning_enabled"`
+	AdvertisingEnabled       types.Bool   `tfsdk:"advertising_enabled"`
+	UUID                     types.String `tfsdk:"uuid"`
+	MajorMinorAssignmentMode types.String `tfsdk:"major_minor_assignment_mode"`
+	Major                    types.Int64  `tfsdk:"major"`
+	Minor                    types.Int64  `tfsdk:"minor"`
+}
+
+func (w *wirelessBluetoothSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_bluetooth_settings"
+}
+
+// beaconUUIDAttribute returns the optional iBeacon UUID attribute, which the dashboard assigns when it is not set.
+func beaconUUIDAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(beaconUUIDRegexp, "must be a UUID, e.g. '00000000-0000-0000-0000-000000000000'"),
+		},
+	}
+}
+
+func (w *wirelessBluetoothSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Bluetooth settings of a wireless network. Scanning and advertising are disabled again on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scanning_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the access points scan for Bluetooth clients. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"advertising_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the access points advertise iBeacons. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"uuid": beaconUUIDAttribute("The iBeacon UUID advertised by the access points, assigned by the dashboard when not set"),
+			"major_minor_assignment_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether every access point gets its own major and minor ('Unique') or all advertise major and minor ('Non-unique'). Defaults to 'Unique'",
+				Default:     stringdefault.StaticString("Unique"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Unique", "Non-unique"),
+				},
+			},
+			"major": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The iBeacon major advertised by all access points, required when major_minor_assignment_mode is 'Non-unique'",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"minor": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The iBeacon minor advertised by all access points, required when major_minor_assignment_mode is 'Non-unique'",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessBluetoothSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless bluetooth settings resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless bluetooth settings resource")
+}
+
+func (w *wirelessBluetoothSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WirelessBluetoothSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.MajorMinorAssignmentMode.IsUnknown() {
+		return
+	}
+	nonUnique := config.MajorMinorAssignmentMode.ValueString() == "Non-unique"
+	for name, value := range map[string]types.Int64{"major": config.Major, "minor": config.Minor} {
+		if nonUnique && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Bluetooth setting",
+				fmt.Sprintf("%s is required when major_minor_assignment_mode is 'Non-unique'", name),
+			)
+		}
+		if !nonUnique && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Bluetooth setting",
+				fmt.Sprintf("%s can only be set when major_minor_assignment_mode is 'Non-unique'", name),
+			)
+		}
+	}
+}
+
+func (w *wirelessBluetoothSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless bluetooth settings resource")
+	var plan WirelessBluetoothSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.UpdateWirelessBluetoothSettings(plan.NetworkID.ValueString(), bluetoothSettingsToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless bluetooth settings", "Failed to update wireless bluetooth settings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	bluetoothSettingsFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless bluetooth settings resource")
+}
+
+func (w *wirelessBluetoothSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless bluetooth settings resource")
+	var state WirelessBluetoothSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.GetWirelessBluetoothSettings(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless bluetooth settings", "Failed to get wireless bluetooth settings: "+err.Error())
+		return
+	}
+
+	bluetoothSettingsFromAPI(settings, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless bluetooth settings resource")
+}
+
+func (w *wirelessBluetoothSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless bluetooth settings resource")
+	var plan WirelessBluetoothSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.UpdateWirelessBluetoothSettings(plan.NetworkID.ValueString(), bluetoothSettingsToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless bluetooth settings", "Failed to update wireless bluetooth settings: "+err.Error())
+		return
+	}
+
+	bluetoothSettingsFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless bluetooth settings resource")
+}
+
+func (w *wirelessBluetoothSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless bluetooth settings resource")
+	var state WirelessBluetoothSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := w.client.UpdateWirelessBluetoothSettings(state.NetworkID.ValueString(), &meraki.WirelessBluetoothSettings{
+		ScanningEnabled:    false,
+		AdvertisingEnabled: false,
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to reset wireless bluetooth settings", "Failed to reset wireless bluetooth settings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless bluetooth settings resource")
+}
+
+func (w *wirelessBluetoothSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func bluetoothSettingsToAPI(plan *WirelessBluetoothSettingsResourceModel) *meraki.WirelessBluetoothSettings {
+	settings := &meraki.WirelessBluetoothSettings{
+		ScanningEnabled:          plan.ScanningEnabled.ValueBool(),
+		AdvertisingEnabled:       plan.AdvertisingEnabled.ValueBool(),
+		UUID:                     plan.UUID.ValueString(),
+		MajorMinorAssignmentMode: plan.MajorMinorAssignmentMode.ValueString(),
+	}
+	if !plan.Major.IsNull() && !plan.Major.IsUnknown() {
+		major := plan.Major.ValueInt64()
+		settings.Major = &major
+	}
+	if !plan.Minor.IsNull() && !plan.Minor.IsUnknown() {
+		minor := plan.Minor.ValueInt64()
+		settings.Minor = &minor
+	}
+	return settings
+}
+
+func bluetoothSettingsFromAPI(settings *meraki.WirelessBluetoothSettings, state *WirelessBluetoothSettingsResourceModel) {
+	state.ScanningEnabled = types.BoolValue(settings.ScanningEnabled)
+	state.AdvertisingEnabled = types.BoolValue(settings.AdvertisingEnabled)
+	state.UUID = helpers.KeepCase(state.UUID, settings.UUID)
+	state.MajorMinorAssignmentMode = types.StringValue(settings.MajorMinorAssignmentMode)
+
+	// major and minor are only used in the 'Non-unique' mode
+	state.Major, state.Minor = types.Int64Null(), types.Int64Null()
+	if settings.MajorMinorAssignmentMode == "Non-unique" {
+		if settings.Major != nil {
+			state.Major = types.Int64Value(*settings.Major)
+		}
+		if settings.Minor != nil {
+			state.Minor = types.Int64Value(*settings.Minor)
+		}
+	}
+}
@@ -0,0 +1,599 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+)
+
+var (
+	_ resource.Resource                   = &wirelessRfProfileResource{}
+	_ resource.ResourceWithConfigure      = &wirelessRfProfileResource{}
+	_ resource.ResourceWithImportState    = &wirelessRfProfileResource{}
+	_ resource.ResourceWithValidateConfig = &wirelessRfProfileResource{}
+)
+
+var (
+	bandOperationModes = []string{"dual", "2.4ghz", "5ghz", "6ghz", "multi"}
+
+	twoFourGhzBitrates = []float64{1, 2, 5.5, 6, 9, 11, 12, 18, 24, 36, 48, 54}
+	fiveGhzBitrates    = []float64{6, 9, 12, 18, 24, 36, 48, 54}
+
+	twoFourGhzChannels = []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14}
+	fiveGhzChannels    = []int64{36, 40, 44, 48, 52, 56, 60, 64, 100, 104, 108, 112, 116, 120, 124, 128, 132, 136, 140, 144, 149, 153, 157, 161, 165, 169, 173, 177}
+	sixGhzChannels     = func() []int64 {
+		var channels []int64
+		for channel := int64(1); channel <= 233; channel += 4 {
+			channels = append(channels, channel)
+		}
+		return channels
+	}()
+)
+
+func NewWirelessRfProfileResource() resource.Resource {
+	return &wirelessRfProfileResource{}
+}
+
+type wirelessRfProfileResource struct {
+	client meraki.Client
+}
+
+type WirelessRfProfileResourceModel struct {
+	ID                     types.String                          `tfsdk:"id"`
+	NetworkID              types.String                          `tfsdk:"network_id"`
+	RfProfileID            types.String                          `tfsdk:"rf_profile_id"`
+	Name                   types.String                          `tfsdk:"name"`
+	BandSelectionType      types.String                          `tfsdk:"band_selection_type"`
+	ClientBalancingEnabled types.Bool                            `tfsdk:"client_balancing_enabled"`
+	MinBitrateType         types.String                          `tfsdk:"min_bitrate_type"`
+	ApBandSettings         *RfProfileBandSettingsModel           `tfsdk:"ap_band_settings"`
+	TwoFourGhzSettings     *RfProfileTwoFourGhzSettingsModel     `tfsdk:"two_four_ghz_settings"`
+	FiveGhzSettings        *RfProfileRadioSettingsModel          `tfsdk:"five_ghz_settings"`
+	SixGhzSettings         *RfProfileRadioSettingsModel          `tfsdk:"six_ghz_settings"`
+	PerSsidSettings        map[string]RfProfileSsidSettingsModel `tfsdk:"per_ssid_settings"`
+}
+
+type RfProfileBandSettingsModel struct {
+	BandOperationMode   types.String `tfsdk:"band_operation_mode"`
+	BandSteeringEnabled types.Bool   `tfsdk:"band_steering_enabled"`
+}
+
+type RfProfileSsidSettingsModel struct {
+	MinBitrate          types.Float64 `tfsdk:"min_bitrate"`
+	BandOperationMode   types.String  `tfsdk:"band_operation_mode"`
+	BandSteeringEnabled types.Bool    `tfsdk:"band_steering_enabled"`
+}
+
+type RfProfileTwoFourGhzSettingsModel struct {
+	MaxPower          types.Int64   `tfsdk:"max_power"`
+	MinPower          types.Int64   `tfsdk:"min_power"`
+	MinBitrate        types.Float64 `tfsdk:"min_bitrate"`
+	ValidAutoChannels []types.Int64 `tfsdk:"valid_auto_channels"`
+	AxEnabled         types.Bool    `tfsdk:"ax_enabled"`
+}
+
+type RfProfileRadioSettingsModel struct {
+	MaxPower          types.Int64   `tfsdk:"max_power"`
+	MinPower          types.Int64   `tfsdk:"min_power"`
+	MinBitrate        types.Float64 `tfsdk:"min_bitrate"`
+	ValidAutoChannels []types.Int64 `tfsdk:"valid_auto_channels"`
+	ChannelWidth      types.String  `tfsdk:"channel_width"`
+}
+
+func (w *wirelessRfProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_rf_profile"
+}
+
+// radioAttributes returns the power, bitrate and channel attributes shared by the settings of all bands.
+func radioAttributes(minPower int64, minBitrate float64, bitrates []float64, channels []int64) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"max_power": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The maximum transmit power in dBm. Defaults to 30",
+			Default:     int64default.StaticInt64(30),
+			Validators: []validator.Int64{
+				int64validator.Between(minPower, 30),
+			},
+		},
+		"min_power": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The minimum transmit power in dBm. Defaults to %d", minPower),
+			Default:     int64default.StaticInt64(minPower),
+			Validators: []validator.Int64{
+				int64validator.Between(minPower, 30),
+			},
+		},
+		"min_bitrate": schema.Float64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The minimum bitrate in Mbps, used when min_bitrate_type is 'band'. Defaults to %g", minBitrate),
+			Default:     float64default.StaticFloat64(minBitrate),
+			Validators: []validator.Float64{
+				float64validator.OneOf(bitrates...),
+			},
+		},
+		"valid_auto_channels": schema.ListAttribute{
+			Optional:    true,
+			Description: "The channels the radios may pick automatically",
+			ElementType: types.Int64Type,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueInt64sAre(int64validator.OneOf(channels...)),
+			},
+		},
+	}
+}
+
+func channelWidthAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The channel width in MHz, can be 'auto', '20', '40', '80' or '160'. Defaults to 'auto'",
+		Default:     stringdefault.StaticString("auto"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("auto", "20", "40", "80", "160"),
+		},
+	}
+}
+
+func (w *wirelessRfProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	twoFourGhzAttributes := radioAttributes(5, 11, twoFourGhzBitrates, twoFourGhzChannels)
+	twoFourGhzAttributes["ax_enabled"] = schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Whether 802.11ax is enabled on the 2.4 GHz band. Defaults to true",
+		Default:     booldefault.StaticBool(true),
+	}
+	fiveGhzAttributes := radioAttributes(8, 12, fiveGhzBitrates, fiveGhzChannels)
+	fiveGhzAttributes["channel_width"] = channelWidthAttribute()
+	sixGhzAttributes := radioAttributes(8, 12, fiveGhzBitrates, sixGhzChannels)
+	sixGhzAttributes["channel_width"] = channelWidthAttribute()
+
+	ssidNumbers := make([]string, 0, 15)
+	for i := 0; i < 15; i++ {
+		ssidNumbers = append(ssidNumbers, strconv.Itoa(i))
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages an RF profile of a wireless network. A profile that is still assigned to access points cannot be destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/rf_profile_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rf_profile_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the RF profile",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the RF profile, unique within the network",
+			},
+			"band_selection_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Whether the band settings apply per access point ('ap') or per SSID ('ssid')",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ap", "ssid"),
+				},
+			},
+			"client_balancing_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether clients are steered to less busy access points. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"min_bitrate_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the minimum bitrate is set per band ('band') or per SSID ('ssid'). Defaults to 'band'",
+				Default:     stringdefault.StaticString("band"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("band", "ssid"),
+				},
+			},
+			"ap_band_settings": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The band settings of the access points, used when band_selection_type is 'ap'",
+				Attributes: map[string]schema.Attribute{
+					"band_operation_mode": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "The bands the access points broadcast on, can be 'dual', '2.4ghz', '5ghz', '6ghz' or 'multi'. Defaults to 'dual'",
+						Default:     stringdefault.StaticString("dual"),
+						Validators: []validator.String{
+							stringvalidator.OneOf(bandOperationModes...),
+						},
+					},
+					"band_steering_enabled": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether dual band clients are steered to the 5 GHz band. Defaults to true",
+						Default:     booldefault.StaticBool(true),
+					},
+				},
+			},
+			"two_four_ghz_settings": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The settings of the 2.4 GHz band, which always uses 20 MHz channels",
+				Attributes:  twoFourGhzAttributes,
+			},
+			"five_ghz_settings": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The settings of the 5 GHz band",
+				Attributes:  fiveGhzAttributes,
+			},
+			"six_ghz_settings": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The settings of the 6 GHz band",
+				Attributes:  sixGhzAttributes,
+			},
+			"per_ssid_settings": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "The band settings of SSIDs keyed by SSID number, used when band_selection_type or min_bitrate_type is 'ssid'",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(ssidNumbers...)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"min_bitrate": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The minimum bitrate of the SSID in Mbps, used when min_bitrate_type is 'ssid'",
+							Validators: []validator.Float64{
+								float64validator.OneOf(twoFourGhzBitrates...),
+							},
+						},
+						"band_operation_mode": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The bands the SSID is broadcast on, can be 'dual', '2.4ghz', '5ghz', '6ghz' or 'multi'. Defaults to 'dual'",
+							Default:     stringdefault.StaticString("dual"),
+							Validators: []validator.String{
+								stringvalidator.OneOf(bandOperationModes...),
+							},
+						},
+						"band_steering_enabled": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether dual band clients of the SSID are steered to the 5 GHz band. Defaults to false",
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessRfProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless RF profile resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless RF profile resource")
+}
+
+func (w *wirelessRfProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WirelessRfProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatePower := func(name string, minPower, maxPower types.Int64) {
+		if minPower.IsNull() || minPower.IsUnknown() || maxPower.IsNull() || maxPower.IsUnknown() {
+			return
+		}
+		if minPower.ValueInt64() > maxPower.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name).AtName("min_power"),
+				"Invalid RF profile power range",
+				fmt.Sprintf("min_power %d is greater than max_power %d", minPower.ValueInt64(), maxPower.ValueInt64()),
+			)
+		}
+	}
+	if s := config.TwoFourGhzSettings; s != nil {
+		validatePower("two_four_ghz_settings", s.MinPower, s.MaxPower)
+	}
+	if s := config.FiveGhzSettings; s != nil {
+		validatePower("five_ghz_settings", s.MinPower, s.MaxPower)
+	}
+	if s := config.SixGhzSettings; s != nil {
+		validatePower("six_ghz_settings", s.MinPower, s.MaxPower)
+	}
+
+	// an unset min_bitrate_type defaults to 'band'
+	if config.MinBitrateType.IsUnknown() || config.MinBitrateType.ValueString() == "ssid" {
+		return
+	}
+	for number, settings := range config.PerSsidSettings {
+		if !settings.MinBitrate.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("per_ssid_settings").AtMapKey(number).AtName("min_bitrate"),
+				"Invalid RF profile SSID settings",
+				"min_bitrate can only be set per SSID when min_bitrate_type is 'ssid'",
+			)
+		}
+	}
+}
+
+func (w *wirelessRfProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless RF profile resource")
+	var plan WirelessRfProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := plan.NetworkID.ValueString()
+	profile, err := w.client.CreateWirelessRfProfile(networkID, rfProfileToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create wireless RF profile", "Failed to create wireless RF profile: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(networkID + "/" + profile.ID)
+	plan.RfProfileID = types.StringValue(profile.ID)
+	rfProfileFromAPI(profile, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless RF profile resource")
+}
+
+func (w *wirelessRfProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless RF profile resource")
+	var state WirelessRfProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := w.client.GetWirelessRfProfile(state.NetworkID.ValueString(), state.RfProfileID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless RF profile", "Failed to get wireless RF profile: "+err.Error())
+		return
+	}
+
+	rfProfileFromAPI(profile, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless RF profile resource")
+}
+
+func (w *wirelessRfProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless RF profile resource")
+	var plan WirelessRfProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := w.client.UpdateWirelessRfProfile(plan.NetworkID.ValueString(), plan.RfProfileID.ValueString(), rfProfileToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless RF profile", "Failed to update wireless RF profile: "+err.Error())
+		return
+	}
+
+	rfProfileFromAPI(profile, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless RF profile resource")
+}
+
+func (w *wirelessRfProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless RF profile resource")
+	var state WirelessRfProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := w.client.DeleteWirelessRfProfile(state.NetworkID.ValueString(), state.RfProfileID.ValueString())
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete wireless RF profile", "Failed to delete wireless RF profile: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless RF profile resource")
+}
+
+func (w *wirelessRfProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, rfProfileID, err := helpers.SplitImportID(req.ID, "network_id/rf_profile_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rf_profile_id"), rfProfileID)...)
+}
+
+func rfProfileToAPI(plan *WirelessRfProfileResourceModel) *meraki.WirelessRfProfile {
+	clientBalancingEnabled := plan.ClientBalancingEnabled.ValueBool()
+	profile := &meraki.WirelessRfProfile{
+		Name:                   plan.Name.ValueString(),
+		BandSelectionType:      plan.BandSelectionType.ValueString(),
+		ClientBalancingEnabled: &clientBalancingEnabled,
+		MinBitrateType:         plan.MinBitrateType.ValueString(),
+	}
+	if s := plan.ApBandSettings; s != nil {
+		bandSteeringEnabled := s.BandSteeringEnabled.ValueBool()
+		profile.ApBandSettings = &meraki.WirelessRfProfileBandSettings{
+			BandOperationMode:   s.BandOperationMode.ValueString(),
+			BandSteeringEnabled: &bandSteeringEnabled,
+		}
+	}
+	if s := plan.TwoFourGhzSettings; s != nil {
+		axEnabled := s.AxEnabled.ValueBool()
+		profile.TwoFourGhzSettings = &meraki.WirelessRfProfileRadioSettings{
+			MaxPower:          s.MaxPower.ValueInt64(),
+			MinPower:          s.MinPower.ValueInt64(),
+			MinBitrate:        s.MinBitrate.ValueFloat64(),
+			ValidAutoChannels: channelsToAPI(s.ValidAutoChannels),
+			AxEnabled:         &axEnabled,
+		}
+	}
+	profile.FiveGhzSettings = radioSettingsToAPI(plan.FiveGhzSettings)
+	profile.SixGhzSettings = radioSettingsToAPI(plan.SixGhzSettings)
+	if len(plan.PerSsidSettings) > 0 {
+		profile.PerSsidSettings = make(map[string]meraki.WirelessRfProfileSsidSettings, len(plan.PerSsidSettings))
+		for number, s := range plan.PerSsidSettings {
+			bandSteeringEnabled := s.BandSteeringEnabled.ValueBool()
+			profile.PerSsidSettings[number] = meraki.WirelessRfProfileSsidSettings{
+				MinBitrate:          s.MinBitrate.ValueFloat64(),
+				BandOperationMode:   s.BandOperationMode.ValueString(),
+				BandSteeringEnabled: &bandSteeringEnabled,
+			}
+		}
+	}
+	return profile
+}
+
+func radioSettingsToAPI(settings *RfProfileRadioSettingsModel) *meraki.WirelessRfProfileRadioSettings {
+	if settings == nil {
+		return nil
+	}
+	return &meraki.WirelessRfProfileRadioSettings{
+		MaxPower:          settings.MaxPower.ValueInt64(),
+		MinPower:          settings.MinPower.ValueInt64(),
+		MinBitrate:        settings.MinBitrate.ValueFloat64(),
+		ValidAutoChannels: channelsToAPI(settings.ValidAutoChannels),
+		ChannelWidth:      settings.ChannelWidth.ValueString(),
+	}
+}
+
+func channelsToAPI(channels []types.Int64) []int64 {
+	var result []int64
+	for _, channel := range channels {
+		result = append(result, channel.ValueInt64())
+	}
+	return result
+}
+
+func rfProfileFromAPI(profile *meraki.WirelessRfProfile, state *WirelessRfProfileResourceModel) {
+	state.Name = types.StringValue(profile.Name)
+	state.BandSelectionType = types.StringValue(profile.BandSelectionType)
+	state.ClientBalancingEnabled = types.BoolValue(profile.ClientBalancingEnabled == nil || *profile.ClientBalancingEnabled)
+	state.MinBitrateType = types.StringValue(profile.MinBitrateType)
+
+	// the API reports the settings of all bands and SSIDs, only track the configured ones
+	if state.ApBandSettings != nil && profile.ApBandSettings != nil {
+		state.ApBandSettings = &RfProfileBandSettingsModel{
+			BandOperationMode:   types.StringValue(profile.ApBandSettings.BandOperationMode),
+			BandSteeringEnabled: types.BoolValue(profile.ApBandSettings.BandSteeringEnabled != nil && *profile.ApBandSettings.BandSteeringEnabled),
+		}
+	}
+	if state.TwoFourGhzSettings != nil && profile.TwoFourGhzSettings != nil {
+		s := profile.TwoFourGhzSettings
+		state.TwoFourGhzSettings = &RfProfileTwoFourGhzSettingsModel{
+			MaxPower:          types.Int64Value(s.MaxPower),
+			MinPower:          types.Int64Value(s.MinPower),
+			MinBitrate:        types.Float64Value(s.MinBitrate),
+			ValidAutoChannels: channelsFromAPI(state.TwoFourGhzSettings.ValidAutoChannels, s.ValidAutoChannels),
+			AxEnabled:         types.BoolValue(s.AxEnabled == nil || *s.AxEnabled),
+		}
+	}
+	state.FiveGhzSettings = radioSettingsFromAPI(state.FiveGhzSettings, profile.FiveGhzSettings)
+	state.SixGhzSettings = radioSettingsFromAPI(state.SixGhzSettings, profile.SixGhzSettings)
+
+	for number, prior := range state.PerSsidSettings {
+		s, ok := profile.PerSsidSettings[number]
+		if !ok {
+			delete(state.PerSsidSettings, number)
+			continue
+		}
+		settings := RfProfileSsidSettingsModel{
+			MinBitrate:          types.Float64Null(),
+			BandOperationMode:   types.StringValue(s.BandOperationMode),
+			BandSteeringEnabled: types.BoolValue(s.BandSteeringEnabled != nil && *s.BandSteeringEnabled),
+		}
+		if !prior.MinBitrate.IsNull() {
+			settings.MinBitrate = types.Float64Value(s.MinBitrate)
+		}
+		state.PerSsidSettings[number] = settings
+	}
+}
+
+func radioSettingsFromAPI(prior *RfProfileRadioSettingsModel, settings *meraki.WirelessRfProfileRadioSettings) *RfProfileRadioSettingsModel {
+	if prior == nil || settings == nil {
+		return prior
+	}
+	return &RfProfileRadioSettingsModel{
+		MaxPower:          types.Int64Value(settings.MaxPower),
+		MinPower:          types.Int64Value(settings.MinPower),
+		MinBitrate:        types.Float64Value(settings.MinBitrate),
+		ValidAutoChannels: channelsFromAPI(prior.ValidAutoChannels, settings.ValidAutoChannels),
+		ChannelWidth:      types.StringValue(settings.ChannelWidth),
+	}
+}
+
+// channelsFromAPI returns the valid auto channels when they are configured, the API reports all
+// channels of the band otherwise.
+func channelsFromAPI(prior []types.Int64, channels []int64) []types.Int64 {
+	if prior == nil {
+		return nil
+	}
+	result := make([]types.Int64, 0, len(channels))
+	for _, channel := range channels {
+		result = append(result, types.Int64Value(channel))
+	}
+	return result
+}
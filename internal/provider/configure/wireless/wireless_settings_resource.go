@@ -0,0 +1,239 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &wirelessSettingsResource{}
+	_ resource.ResourceWithConfigure   = &wirelessSettingsResource{}
+	_ resource.ResourceWithImportState = &wirelessSettingsResource{}
+)
+
+func NewWirelessSettingsResource() resource.Resource {
+	return &wirelessSettingsResource{}
+}
+
+type wirelessSettingsResource struct {
+	client meraki.Client
+}
+
+type WirelessSettingsResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	NetworkID                types.String `tfsdk:"network_id"`
+	MeshingEnabled           types.Bool   `tfsdk:"meshing_enabled"`
+	Ipv6BridgeEnabled        types.Bool   `tfsdk:"ipv6_bridge_enabled"`
+	LocationAnalyticsEnabled types.Bool   `tfsdk:"location_analytics_enabled"`
+	UpgradeStrategy          types.String `tfsdk:"upgrade_strategy"`
+	LedLightsOn              types.Bool   `tfsdk:"led_lights_on"`
+}
+
+func (w *wirelessSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_settings"
+}
+
+func (w *wirelessSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the network-wide wireless settings of a network. The settings are reset to their defaults on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource, same as the network ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"meshing_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether access points without a wired uplink can mesh through other access points. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"ipv6_bridge_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether IPv6 traffic of clients is bridged on SSIDs in NAT mode. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"location_analytics_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether location analytics is enabled. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"upgrade_strategy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How firmware upgrades are rolled out, can be 'minimizeUpgradeTime' or 'minimizeClientDowntime'. Defaults to 'minimizeUpgradeTime'",
+				Default:     stringdefault.StaticString("minimizeUpgradeTime"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("minimizeUpgradeTime", "minimizeClientDowntime"),
+				},
+			},
+			"led_lights_on": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the LEDs of the access points are on. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (w *wirelessSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless settings resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless settings resource")
+}
+
+func (w *wirelessSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless settings resource")
+	var plan WirelessSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.UpdateWirelessSettings(plan.NetworkID.ValueString(), wirelessSettingsToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless settings", "Failed to update wireless settings: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.NetworkID
+	wirelessSettingsFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless settings resource")
+}
+
+func (w *wirelessSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless settings resource")
+	var state WirelessSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.GetWirelessSettings(state.NetworkID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless settings", "Failed to get wireless settings: "+err.Error())
+		return
+	}
+
+	wirelessSettingsFromAPI(settings, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless settings resource")
+}
+
+func (w *wirelessSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless settings resource")
+	var plan WirelessSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := w.client.UpdateWirelessSettings(plan.NetworkID.ValueString(), wirelessSettingsToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless settings", "Failed to update wireless settings: "+err.Error())
+		return
+	}
+
+	wirelessSettingsFromAPI(settings, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless settings resource")
+}
+
+func (w *wirelessSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless settings resource")
+	var state WirelessSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := w.client.UpdateWirelessSettings(state.NetworkID.ValueString(), &meraki.WirelessSettings{
+		MeshingEnabled:           true,
+		Ipv6BridgeEnabled:        false,
+		LocationAnalyticsEnabled: false,
+		UpgradeStrategy:          "minimizeUpgradeTime",
+		LedLightsOn:              true,
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to reset wireless settings", "Failed to reset wireless settings: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless settings resource")
+}
+
+func (w *wirelessSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+}
+
+func wirelessSettingsToAPI(plan *WirelessSettingsResourceModel) *meraki.WirelessSettings {
+	return &meraki.WirelessSettings{
+		MeshingEnabled:           plan.MeshingEnabled.ValueBool(),
+		Ipv6BridgeEnabled:        plan.Ipv6BridgeEnabled.ValueBool(),
+		LocationAnalyticsEnabled: plan.LocationAnalyticsEnabled.ValueBool(),
+		UpgradeStrategy:          plan.UpgradeStrategy.ValueString(),
+		LedLightsOn:              plan.LedLightsOn.ValueBool(),
+	}
+}
+
+func wirelessSettingsFromAPI(settings *meraki.WirelessSettings, state *WirelessSettingsResourceModel) {
+	state.MeshingEnabled = types.BoolValue(settings.MeshingEnabled)
+	state.Ipv6BridgeEnabled = types.BoolValue(settings.Ipv6BridgeEnabled)
+	state.LocationAnalyticsEnabled = types.BoolValue(settings.LocationAnalyticsEnabled)
+	state.UpgradeStrategy = types.StringValue(settings.UpgradeStrategy)
+	state.LedLightsOn = types.BoolValue(settings.LedLightsOn)
+}
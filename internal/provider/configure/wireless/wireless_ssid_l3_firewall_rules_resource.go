@@ -0,0 +1,342 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/validators"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &wirelessSSIDL3FirewallRulesResource{}
+	_ resource.ResourceWithConfigure      = &wirelessSSIDL3FirewallRulesResource{}
+	_ resource.ResourceWithImportState    = &wirelessSSIDL3FirewallRulesResource{}
+	_ resource.ResourceWithValidateConfig = &wirelessSSIDL3FirewallRulesResource{}
+)
+
+// localLanCidr is the destination the API uses for the rule generated from allowLanAccess.
+const localLanCidr = "Local LAN"
+
+func NewWirelessSSIDL3FirewallRulesResource() resource.Resource {
+	return &wirelessSSIDL3FirewallRulesResource{}
+}
+
+type wirelessSSIDL3FirewallRulesResource struct {
+	client meraki.Client
+}
+
+type WirelessSSIDL3FirewallRulesResourceModel struct {
+	ID             types.String                    `tfsdk:"id"`
+	NetworkID      types.String                    `tfsdk:"network_id"`
+	Number         types.Int64                     `tfsdk:"number"`
+	AllowLanAccess types.Bool                      `tfsdk:"allow_lan_access"`
+	Rules          []WirelessSSIDFirewallRuleModel `tfsdk:"rules"`
+}
+
+type WirelessSSIDFirewallRuleModel struct {
+	Comment  types.String `tfsdk:"comment"`
+	Policy   types.String `tfsdk:"policy"`
+	Protocol types.String `tfsdk:"protocol"`
+	DestCidr types.String `tfsdk:"dest_cidr"`
+	DestPort types.String `tfsdk:"dest_port"`
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_ssid_l3_firewall_rules"
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete, ordered list of L3 firewall rules of an SSID. All rules are removed and LAN access is allowed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the SSID, between 0 and 14",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 14),
+				},
+			},
+			"allow_lan_access": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether wireless clients can access the local LAN, evaluated after the rules. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The L3 firewall rules in the order they are evaluated",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "A description of the rule",
+							Default:     stringdefault.StaticString(""),
+						},
+						"policy": schema.StringAttribute{
+							Required:    true,
+							Description: "The action taken by the rule, can be 'allow' or 'deny'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Required:    true,
+							Description: "The protocol matched by the rule, can be 'tcp', 'udp', 'icmp', 'icmp6' or 'any'",
+							Validators: []validator.String{
+								stringvalidator.OneOfCaseInsensitive("tcp", "udp", "icmp", "icmp6", "any"),
+							},
+						},
+						"dest_cidr": schema.StringAttribute{
+							Required:    true,
+							Description: "Comma separated list of destination IPs and CIDRs, or 'Any'. Use allow_lan_access to control access to the local LAN",
+							Validators: []validator.String{
+								validators.FirewallCidrs(),
+							},
+						},
+						"dest_port": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Comma separated list of destination ports or port ranges, or 'Any'. Only allowed for tcp and udp rules",
+							Default:     stringdefault.StaticString("Any"),
+							Validators: []validator.String{
+								validators.Ports(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless SSID L3 firewall rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless SSID L3 firewall rules resource")
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WirelessSSIDL3FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rules {
+		if rule.Protocol.IsUnknown() || rule.Protocol.IsNull() {
+			continue
+		}
+		protocol := strings.ToLower(rule.Protocol.ValueString())
+		if protocol == "tcp" || protocol == "udp" {
+			continue
+		}
+		if !rule.DestPort.IsUnknown() && !rule.DestPort.IsNull() && !strings.EqualFold(rule.DestPort.ValueString(), "any") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i).AtName("dest_port"),
+				"Invalid firewall rule",
+				fmt.Sprintf("dest_port can only be set for tcp and udp rules, got protocol %q", rule.Protocol.ValueString()),
+			)
+		}
+	}
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless SSID L3 firewall rules resource")
+	var plan WirelessSSIDL3FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number := strconv.FormatInt(plan.Number.ValueInt64(), 10)
+	rules, err := w.client.UpdateWirelessSSIDL3FirewallRules(plan.NetworkID.ValueString(), number, ssidL3FirewallRulesToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID L3 firewall rules", "Failed to update wireless SSID L3 firewall rules: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + number)
+	ssidL3FirewallRulesFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless SSID L3 firewall rules resource")
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless SSID L3 firewall rules resource")
+	var state WirelessSSIDL3FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := w.client.GetWirelessSSIDL3FirewallRules(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10))
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless SSID L3 firewall rules", "Failed to get wireless SSID L3 firewall rules: "+err.Error())
+		return
+	}
+
+	ssidL3FirewallRulesFromAPI(rules, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless SSID L3 firewall rules resource")
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless SSID L3 firewall rules resource")
+	var plan WirelessSSIDL3FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := w.client.UpdateWirelessSSIDL3FirewallRules(plan.NetworkID.ValueString(), strconv.FormatInt(plan.Number.ValueInt64(), 10), ssidL3FirewallRulesToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID L3 firewall rules", "Failed to update wireless SSID L3 firewall rules: "+err.Error())
+		return
+	}
+
+	ssidL3FirewallRulesFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless SSID L3 firewall rules resource")
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless SSID L3 firewall rules resource")
+	var state WirelessSSIDL3FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowLanAccess := true
+	_, err := w.client.UpdateWirelessSSIDL3FirewallRules(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10), &meraki.WirelessSSIDL3FirewallRules{
+		Rules:          []meraki.WirelessSSIDFirewallRule{},
+		AllowLanAccess: &allowLanAccess,
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete wireless SSID L3 firewall rules", "Failed to delete wireless SSID L3 firewall rules: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless SSID L3 firewall rules resource")
+}
+
+func (w *wirelessSSIDL3FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSSIDState(ctx, req, resp)
+}
+
+func ssidL3FirewallRulesToAPI(plan *WirelessSSIDL3FirewallRulesResourceModel) *meraki.WirelessSSIDL3FirewallRules {
+	allowLanAccess := plan.AllowLanAccess.ValueBool()
+	result := &meraki.WirelessSSIDL3FirewallRules{
+		Rules:          make([]meraki.WirelessSSIDFirewallRule, 0, len(plan.Rules)),
+		AllowLanAccess: &allowLanAccess,
+	}
+	for _, rule := range plan.Rules {
+		result.Rules = append(result.Rules, meraki.WirelessSSIDFirewallRule{
+			Comment:  rule.Comment.ValueString(),
+			Policy:   rule.Policy.ValueString(),
+			Protocol: rule.Protocol.ValueString(),
+			DestCidr: rule.DestCidr.ValueString(),
+			DestPort: rule.DestPort.ValueString(),
+		})
+	}
+	return result
+}
+
+// ssidL3FirewallRulesFromAPI converts the rules returned by the API. The LAN access rule and the
+// default rule the API appends are dropped, allow_lan_access is read from the former. Values that
+// only differ in case from the prior rule at the same position are kept as configured.
+func ssidL3FirewallRulesFromAPI(rules *meraki.WirelessSSIDL3FirewallRules, state *WirelessSSIDL3FirewallRulesResourceModel) {
+	if rules.AllowLanAccess != nil {
+		state.AllowLanAccess = types.BoolValue(*rules.AllowLanAccess)
+	}
+
+	result := make([]WirelessSSIDFirewallRuleModel, 0, len(rules.Rules))
+	for _, rule := range rules.Rules {
+		if rule.DestCidr == localLanCidr {
+			state.AllowLanAccess = types.BoolValue(rule.Policy == "allow")
+			continue
+		}
+		if rule.Comment == "Default rule" && strings.EqualFold(rule.DestCidr, "any") {
+			continue
+		}
+
+		var previous WirelessSSIDFirewallRuleModel
+		if len(result) < len(state.Rules) {
+			previous = state.Rules[len(result)]
+		}
+		result = append(result, WirelessSSIDFirewallRuleModel{
+			Comment:  types.StringValue(rule.Comment),
+			Policy:   helpers.KeepCase(previous.Policy, rule.Policy),
+			Protocol: helpers.KeepCase(previous.Protocol, rule.Protocol),
+			DestCidr: helpers.KeepCase(previous.DestCidr, rule.DestCidr),
+			DestPort: helpers.KeepCase(previous.DestPort, rule.DestPort),
+		})
+	}
+	state.Rules = result
+
+	if state.AllowLanAccess.IsNull() || state.AllowLanAccess.IsUnknown() {
+		state.AllowLanAccess = types.BoolValue(true)
+	}
+}
@@ -0,0 +1,253 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+)
+
+var (
+	_ resource.Resource                = &wirelessSSIDL7FirewallRulesResource{}
+	_ resource.ResourceWithConfigure   = &wirelessSSIDL7FirewallRulesResource{}
+	_ resource.ResourceWithImportState = &wirelessSSIDL7FirewallRulesResource{}
+)
+
+func NewWirelessSSIDL7FirewallRulesResource() resource.Resource {
+	return &wirelessSSIDL7FirewallRulesResource{}
+}
+
+type wirelessSSIDL7FirewallRulesResource struct {
+	client meraki.Client
+}
+
+type WirelessSSIDL7FirewallRulesResourceModel struct {
+	ID        types.String                      `tfsdk:"id"`
+	NetworkID types.String                      `tfsdk:"network_id"`
+	Number    types.Int64                       `tfsdk:"number"`
+	Rules     []WirelessSSIDL7FirewallRuleModel `tfsdk:"rules"`
+}
+
+type WirelessSSIDL7FirewallRuleModel struct {
+	Policy types.String `tfsdk:"policy"`
+	Type   types.String `tfsdk:"type"`
+	Value  types.String `tfsdk:"value"`
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_ssid_l7_firewall_rules"
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete, ordered list of L7 firewall rules of an SSID. All rules are removed on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the SSID, between 0 and 14",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 14),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The L7 firewall rules",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The policy applied to matching traffic, only 'deny' is supported",
+							Default:     stringdefault.StaticString("deny"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("deny"),
+							},
+						},
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of the rule, can be 'application', 'applicationCategory', 'host', 'port' or 'ipRange'",
+							Validators: []validator.String{
+								stringvalidator.OneOf("application", "applicationCategory", "host", "port", "ipRange"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+							Description: "The host, port or IP range matched by the rule, or the ID of the application or application category, " +
+								"e.g. 'meraki:layer7/category/24'",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless SSID L7 firewall rules resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless SSID L7 firewall rules resource")
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless SSID L7 firewall rules resource")
+	var plan WirelessSSIDL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number := strconv.FormatInt(plan.Number.ValueInt64(), 10)
+	rules, err := w.client.UpdateWirelessSSIDL7FirewallRules(plan.NetworkID.ValueString(), number, ssidL7FirewallRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID L7 firewall rules", "Failed to update wireless SSID L7 firewall rules: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + number)
+	plan.Rules = ssidL7FirewallRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless SSID L7 firewall rules resource")
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless SSID L7 firewall rules resource")
+	var state WirelessSSIDL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := w.client.GetWirelessSSIDL7FirewallRules(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10))
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless SSID L7 firewall rules", "Failed to get wireless SSID L7 firewall rules: "+err.Error())
+		return
+	}
+
+	state.Rules = ssidL7FirewallRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless SSID L7 firewall rules resource")
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless SSID L7 firewall rules resource")
+	var plan WirelessSSIDL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := w.client.UpdateWirelessSSIDL7FirewallRules(plan.NetworkID.ValueString(), strconv.FormatInt(plan.Number.ValueInt64(), 10), ssidL7FirewallRulesToAPI(plan.Rules))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID L7 firewall rules", "Failed to update wireless SSID L7 firewall rules: "+err.Error())
+		return
+	}
+
+	plan.Rules = ssidL7FirewallRulesFromAPI(rules.Rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless SSID L7 firewall rules resource")
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless SSID L7 firewall rules resource")
+	var state WirelessSSIDL7FirewallRulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := w.client.UpdateWirelessSSIDL7FirewallRules(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10), &meraki.WirelessSSIDL7FirewallRules{
+		Rules: []meraki.ApplianceL7FirewallRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete wireless SSID L7 firewall rules", "Failed to delete wireless SSID L7 firewall rules: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless SSID L7 firewall rules resource")
+}
+
+func (w *wirelessSSIDL7FirewallRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSSIDState(ctx, req, resp)
+}
+
+func ssidL7FirewallRulesToAPI(rules []WirelessSSIDL7FirewallRuleModel) *meraki.WirelessSSIDL7FirewallRules {
+	result := &meraki.WirelessSSIDL7FirewallRules{
+		Rules: make([]meraki.ApplianceL7FirewallRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		result.Rules = append(result.Rules, meraki.ApplianceL7FirewallRule{
+			Policy: rule.Policy.ValueString(),
+			Type:   rule.Type.ValueString(),
+			Value:  rule.Value.ValueString(),
+		})
+	}
+	return result
+}
+
+func ssidL7FirewallRulesFromAPI(rules []meraki.ApplianceL7FirewallRule) []WirelessSSIDL7FirewallRuleModel {
+	result := make([]WirelessSSIDL7FirewallRuleModel, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, WirelessSSIDL7FirewallRuleModel{
+			Policy: types.StringValue(rule.Policy),
+			Type:   types.StringValue(rule.Type),
+			Value:  types.StringValue(rule.Value),
+		})
+	}
+	return result
+}
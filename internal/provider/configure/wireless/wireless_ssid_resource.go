@@ -0,0 +1,570 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+)
+
+var (
+	_ resource.Resource                   = &wirelessSSIDResource{}
+	_ resource.ResourceWithConfigure      = &wirelessSSIDResource{}
+	_ resource.ResourceWithImportState    = &wirelessSSIDResource{}
+	_ resource.ResourceWithValidateConfig = &wirelessSSIDResource{}
+)
+
+// radiusAuthModes are the auth modes that authenticate clients against the configured RADIUS servers.
+var radiusAuthModes = map[string]bool{
+	"open-with-radius": true,
+	"8021x-radius":     true,
+	"ipsk-with-radius": true,
+}
+
+func NewWirelessSSIDResource() resource.Resource {
+	return &wirelessSSIDResource{}
+}
+
+type wirelessSSIDResource struct {
+	client meraki.Client
+}
+
+type WirelessSSIDResourceModel struct {
+	ID                          types.String        `tfsdk:"id"`
+	NetworkID                   types.String        `tfsdk:"network_id"`
+	Number                      types.Int64         `tfsdk:"number"`
+	Name                        types.String        `tfsdk:"name"`
+	Enabled                     types.Bool          `tfsdk:"enabled"`
+	AuthMode                    types.String        `tfsdk:"auth_mode"`
+	EncryptionMode              types.String        `tfsdk:"encryption_mode"`
+	WpaEncryptionMode           types.String        `tfsdk:"wpa_encryption_mode"`
+	PSK                         types.String        `tfsdk:"psk"`
+	RadiusServers               []RadiusServerModel `tfsdk:"radius_servers"`
+	RadiusAccountingEnabled     types.Bool          `tfsdk:"radius_accounting_enabled"`
+	RadiusAccountingServers     []RadiusServerModel `tfsdk:"radius_accounting_servers"`
+	IPAssignmentMode            types.String        `tfsdk:"ip_assignment_mode"`
+	UseVlanTagging              types.Bool          `tfsdk:"use_vlan_tagging"`
+	DefaultVlanID               types.Int64         `tfsdk:"default_vlan_id"`
+	BandSelection               types.String        `tfsdk:"band_selection"`
+	MinBitrate                  types.Float64       `tfsdk:"min_bitrate"`
+	Visible                     types.Bool          `tfsdk:"visible"`
+	PerClientBandwidthLimitUp   types.Int64         `tfsdk:"per_client_bandwidth_limit_up"`
+	PerClientBandwidthLimitDown types.Int64         `tfsdk:"per_client_bandwidth_limit_down"`
+}
+
+type RadiusServerModel struct {
+	Host   types.String `tfsdk:"host"`
+	Port   types.Int64  `tfsdk:"port"`
+	Secret types.String `tfsdk:"secret"`
+}
+
+func (w *wirelessSSIDResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_ssid"
+}
+
+func radiusServersAttribute(description string, defaultPort int64) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:    true,
+		Description: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					Required:    true,
+					Description: "The IP address or hostname of the RADIUS server",
+				},
+				"port": schema.Int64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Description: fmt.Sprintf("The UDP port of the RADIUS server. Defaults to %d", defaultPort),
+					Default:     int64default.StaticInt64(defaultPort),
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"secret": schema.StringAttribute{
+					Required:    true,
+					Sensitive:   true,
+					Description: "The shared secret of the RADIUS server",
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessSSIDResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages one of the SSID slots of a wireless network. SSIDs can not be deleted, the slot is reset to an unconfigured, disabled SSID on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the SSID slot, between 0 and 14",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 14),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the SSID",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the SSID is enabled. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"auth_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The association control method of the SSID, e.g. 'open', 'psk', 'open-with-radius', '8021x-meraki' or '8021x-radius'. Defaults to 'open'",
+				Default:     stringdefault.StaticString("open"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"open", "open-enhanced", "psk", "open-with-radius", "open-with-nac", "8021x-meraki", "8021x-nac",
+						"8021x-radius", "8021x-google", "8021x-localradius", "ipsk-with-radius", "ipsk-without-radius",
+					),
+				},
+			},
+			"encryption_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The encryption mode of the SSID, can be 'wep', 'wpa' or 'wpa-eap'. Filled in by the dashboard when not set",
+				Validators: []validator.String{
+					stringvalidator.OneOf("wep", "wpa", "wpa-eap"),
+				},
+			},
+			"wpa_encryption_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The WPA version of the SSID, e.g. 'WPA2 only' or 'WPA3 Transition Mode'. Filled in by the dashboard when not set",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"WPA1 only", "WPA1 and WPA2", "WPA2 only", "WPA3 Transition Mode", "WPA3 only", "WPA3 192-bit Security",
+					),
+				},
+			},
+			"psk": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The passphrase of the SSID, required when auth_mode is 'psk'",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 63),
+				},
+			},
+			"radius_servers": radiusServersAttribute("The RADIUS authentication servers, required for the RADIUS auth modes", 1812),
+			"radius_accounting_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether RADIUS accounting is enabled. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"radius_accounting_servers": radiusServersAttribute("The RADIUS accounting servers, only used when radius_accounting_enabled is true", 1813),
+			"ip_assignment_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How clients get their IP address, e.g. 'NAT mode', 'Bridge mode' or 'Layer 3 roaming'. Defaults to 'NAT mode'",
+				Default:     stringdefault.StaticString("NAT mode"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"NAT mode", "Bridge mode", "Layer 3 roaming", "Ethernet over GRE", "Layer 3 roaming with a concentrator", "VPN",
+					),
+				},
+			},
+			"use_vlan_tagging": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the client traffic is tagged with default_vlan_id. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"default_vlan_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The VLAN the client traffic is tagged with when use_vlan_tagging is true",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"band_selection": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The bands the SSID is broadcast on, can be 'Dual band operation', '5 GHz band only' or 'Dual band operation with Band Steering'. Defaults to 'Dual band operation'",
+				Default:     stringdefault.StaticString("Dual band operation"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("Dual band operation", "5 GHz band only", "Dual band operation with Band Steering"),
+				},
+			},
+			"min_bitrate": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The minimum bitrate of the SSID in Mbps. Defaults to 11",
+				Default:     float64default.StaticFloat64(11),
+				Validators: []validator.Float64{
+					float64validator.OneOf(1, 2, 5.5, 6, 9, 11, 12, 18, 24, 36, 48, 54),
+				},
+			},
+			"visible": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the SSID is advertised. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"per_client_bandwidth_limit_up": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The upload bandwidth limit of each client in Kbps, 0 means no limit. Defaults to 0",
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"per_client_bandwidth_limit_down": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The download bandwidth limit of each client in Kbps, 0 means no limit. Defaults to 0",
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessSSIDResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless SSID resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless SSID resource")
+}
+
+func (w *wirelessSSIDResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WirelessSSIDResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.AuthMode.IsUnknown() {
+		authMode := "open"
+		if !config.AuthMode.IsNull() {
+			authMode = config.AuthMode.ValueString()
+		}
+
+		if authMode == "psk" && config.PSK.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("psk"),
+				"Invalid wireless SSID configuration",
+				"psk is required when auth_mode is 'psk'",
+			)
+		}
+		if authMode != "psk" && !config.PSK.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("psk"),
+				"Invalid wireless SSID configuration",
+				fmt.Sprintf("psk can only be set when auth_mode is 'psk', got auth_mode %q", authMode),
+			)
+		}
+		if radiusAuthModes[authMode] && len(config.RadiusServers) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("radius_servers"),
+				"Invalid wireless SSID configuration",
+				fmt.Sprintf("radius_servers is required when auth_mode is %q", authMode),
+			)
+		}
+		if !radiusAuthModes[authMode] && len(config.RadiusServers) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("radius_servers"),
+				"Invalid wireless SSID configuration",
+				fmt.Sprintf("radius_servers can not be set when auth_mode is %q", authMode),
+			)
+		}
+	}
+
+	if !config.RadiusAccountingEnabled.IsUnknown() && !config.RadiusAccountingEnabled.ValueBool() && len(config.RadiusAccountingServers) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("radius_accounting_servers"),
+			"Invalid wireless SSID configuration",
+			"radius_accounting_servers can only be set when radius_accounting_enabled is true",
+		)
+	}
+
+	if !config.UseVlanTagging.IsUnknown() && !config.UseVlanTagging.ValueBool() && !config.DefaultVlanID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_vlan_id"),
+			"Invalid wireless SSID configuration",
+			"default_vlan_id can only be set when use_vlan_tagging is true",
+		)
+	}
+	if !config.UseVlanTagging.IsUnknown() && config.UseVlanTagging.ValueBool() {
+		if config.DefaultVlanID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_vlan_id"),
+				"Invalid wireless SSID configuration",
+				"default_vlan_id is required when use_vlan_tagging is true",
+			)
+		}
+		if !config.IPAssignmentMode.IsUnknown() && (config.IPAssignmentMode.IsNull() || config.IPAssignmentMode.ValueString() == "NAT mode") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("use_vlan_tagging"),
+				"Invalid wireless SSID configuration",
+				"VLAN tagging is not available when ip_assignment_mode is 'NAT mode'",
+			)
+		}
+	}
+}
+
+func (w *wirelessSSIDResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless SSID resource")
+	var plan WirelessSSIDResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number := strconv.FormatInt(plan.Number.ValueInt64(), 10)
+	ssid, err := w.client.UpdateWirelessSSID(plan.NetworkID.ValueString(), number, ssidToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID", "Failed to update wireless SSID: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + number)
+	ssidFromAPI(ssid, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless SSID resource")
+}
+
+func (w *wirelessSSIDResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless SSID resource")
+	var state WirelessSSIDResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ssid, err := w.client.GetWirelessSSID(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10))
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless SSID", "Failed to get wireless SSID: "+err.Error())
+		return
+	}
+
+	ssidFromAPI(ssid, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless SSID resource")
+}
+
+func (w *wirelessSSIDResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless SSID resource")
+	var plan WirelessSSIDResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ssid, err := w.client.UpdateWirelessSSID(plan.NetworkID.ValueString(), strconv.FormatInt(plan.Number.ValueInt64(), 10), ssidToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID", "Failed to update wireless SSID: "+err.Error())
+		return
+	}
+
+	ssidFromAPI(ssid, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless SSID resource")
+}
+
+func (w *wirelessSSIDResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless SSID resource")
+	var state WirelessSSIDResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := w.client.UpdateWirelessSSID(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10), defaultSSID(state.Number.ValueInt64()))
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to reset wireless SSID", "Failed to reset wireless SSID: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless SSID resource")
+}
+
+func (w *wirelessSSIDResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSSIDState(ctx, req, resp)
+}
+
+// defaultSSID returns the settings of an unconfigured SSID slot, as found in a new network.
+func defaultSSID(number int64) *meraki.WirelessSSID {
+	disabled := false
+	visible := true
+	return &meraki.WirelessSSID{
+		Name:                    fmt.Sprintf("Unconfigured SSID %d", number+1),
+		Enabled:                 false,
+		AuthMode:                "open",
+		IPAssignmentMode:        "NAT mode",
+		UseVlanTagging:          &disabled,
+		RadiusAccountingEnabled: &disabled,
+		BandSelection:           "Dual band operation",
+		MinBitrate:              11,
+		Visible:                 &visible,
+	}
+}
+
+func ssidToAPI(plan *WirelessSSIDResourceModel) *meraki.WirelessSSID {
+	useVlanTagging := plan.UseVlanTagging.ValueBool()
+	radiusAccountingEnabled := plan.RadiusAccountingEnabled.ValueBool()
+	visible := plan.Visible.ValueBool()
+
+	ssid := &meraki.WirelessSSID{
+		Name:                        plan.Name.ValueString(),
+		Enabled:                     plan.Enabled.ValueBool(),
+		AuthMode:                    plan.AuthMode.ValueString(),
+		EncryptionMode:              plan.EncryptionMode.ValueString(),
+		WpaEncryptionMode:           plan.WpaEncryptionMode.ValueString(),
+		PSK:                         plan.PSK.ValueString(),
+		RadiusServers:               radiusServersToAPI(plan.RadiusServers),
+		RadiusAccountingEnabled:     &radiusAccountingEnabled,
+		RadiusAccountingServers:     radiusServersToAPI(plan.RadiusAccountingServers),
+		IPAssignmentMode:            plan.IPAssignmentMode.ValueString(),
+		UseVlanTagging:              &useVlanTagging,
+		BandSelection:               plan.BandSelection.ValueString(),
+		MinBitrate:                  plan.MinBitrate.ValueFloat64(),
+		Visible:                     &visible,
+		PerClientBandwidthLimitUp:   plan.PerClientBandwidthLimitUp.ValueInt64(),
+		PerClientBandwidthLimitDown: plan.PerClientBandwidthLimitDown.ValueInt64(),
+	}
+	if useVlanTagging && !plan.DefaultVlanID.IsNull() && !plan.DefaultVlanID.IsUnknown() {
+		vlanID := plan.DefaultVlanID.ValueInt64()
+		ssid.DefaultVlanID = &vlanID
+	}
+	// the dashboard requires an encryption mode for passphrase protected SSIDs
+	if ssid.AuthMode == "psk" && ssid.EncryptionMode == "" {
+		ssid.EncryptionMode = "wpa"
+	}
+	return ssid
+}
+
+func radiusServersToAPI(servers []RadiusServerModel) []meraki.WirelessSSIDRadiusServer {
+	var result []meraki.WirelessSSIDRadiusServer
+	for _, server := range servers {
+		result = append(result, meraki.WirelessSSIDRadiusServer{
+			Host:   server.Host.ValueString(),
+			Port:   server.Port.ValueInt64(),
+			Secret: server.Secret.ValueString(),
+		})
+	}
+	return result
+}
+
+func ssidFromAPI(ssid *meraki.WirelessSSID, state *WirelessSSIDResourceModel) {
+	state.Name = types.StringValue(ssid.Name)
+	state.Enabled = types.BoolValue(ssid.Enabled)
+	state.AuthMode = types.StringValue(ssid.AuthMode)
+	state.EncryptionMode = helpers.StringValueOrNull(ssid.EncryptionMode)
+	state.WpaEncryptionMode = helpers.StringValueOrNull(ssid.WpaEncryptionMode)
+	// the passphrase is only returned to API keys with write access, keep the configured one otherwise
+	if ssid.PSK != "" || ssid.AuthMode != "psk" {
+		state.PSK = helpers.StringValueOrNull(ssid.PSK)
+	}
+	// the dashboard keeps the RADIUS servers of a previous auth mode, only track the ones in use
+	var radiusServers, radiusAccountingServers []RadiusServerModel
+	if radiusAuthModes[ssid.AuthMode] {
+		radiusServers = radiusServersFromAPI(state.RadiusServers, ssid.RadiusServers)
+	}
+	state.RadiusServers = radiusServers
+	state.RadiusAccountingEnabled = types.BoolValue(ssid.RadiusAccountingEnabled != nil && *ssid.RadiusAccountingEnabled)
+	if state.RadiusAccountingEnabled.ValueBool() {
+		radiusAccountingServers = radiusServersFromAPI(state.RadiusAccountingServers, ssid.RadiusAccountingServers)
+	}
+	state.RadiusAccountingServers = radiusAccountingServers
+	state.IPAssignmentMode = types.StringValue(ssid.IPAssignmentMode)
+	state.UseVlanTagging = types.BoolValue(ssid.UseVlanTagging != nil && *ssid.UseVlanTagging)
+	state.DefaultVlanID = types.Int64Null()
+	if ssid.DefaultVlanID != nil {
+		state.DefaultVlanID = types.Int64Value(*ssid.DefaultVlanID)
+	}
+	state.BandSelection = types.StringValue(ssid.BandSelection)
+	state.MinBitrate = types.Float64Value(ssid.MinBitrate)
+	state.Visible = types.BoolValue(ssid.Visible == nil || *ssid.Visible)
+	state.PerClientBandwidthLimitUp = types.Int64Value(ssid.PerClientBandwidthLimitUp)
+	state.PerClientBandwidthLimitDown = types.Int64Value(ssid.PerClientBandwidthLimitDown)
+}
+
+// radiusServersFromAPI converts the RADIUS servers of an SSID with the secrets of prior, as the API
+// never returns them.
+func radiusServersFromAPI(prior []RadiusServerModel, servers []meraki.WirelessSSIDRadiusServer) []RadiusServerModel {
+	var result []RadiusServerModel
+	for _, server := range servers {
+		result = append(result, RadiusServerModel{
+			Host: types.StringValue(server.Host),
+			Port: types.Int64Value(server.Port),
+		})
+	}
+	return helpers.KeepSecrets(prior, result,
+		func(server RadiusServerModel) string { return server.Host.ValueString() + ":" + server.Port.String() },
+		func(server *RadiusServerModel) *types.String { return &server.Secret },
+	)
+}
@@ -0,0 +1,239 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ resource.Resource                = &wirelessSSIDSplashAuthorizationResource{}
+	_ resource.ResourceWithConfigure   = &wirelessSSIDSplashAuthorizationResource{}
+	_ resource.ResourceWithImportState = &wirelessSSIDSplashAuthorizationResource{}
+)
+
+func NewWirelessSSIDSplashAuthorizationResource() resource.Resource {
+	return &wirelessSSIDSplashAuthorizationResource{}
+}
+
+type wirelessSSIDSplashAuthorizationResource struct {
+	client meraki.Client
+}
+
+type WirelessSSIDSplashAuthorizationResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	NetworkID    types.String `tfsdk:"network_id"`
+	ClientID     types.String `tfsdk:"client_id"`
+	Number       types.Int64  `tfsdk:"number"`
+	AuthorizedAt types.String `tfsdk:"authorized_at"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_ssid_splash_authorization"
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authorizes a client on the sign-on splash page of an SSID. The authorization is revoked on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/client_id/number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID, MAC or IP of the client",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the SSID, between 0 and 14",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 14),
+				},
+			},
+			"authorized_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the client was authorized",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time the authorization of the client expires",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless SSID splash authorization resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless SSID splash authorization resource")
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless SSID splash authorization resource")
+	var plan WirelessSSIDSplashAuthorizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number := strconv.FormatInt(plan.Number.ValueInt64(), 10)
+	status, err := w.client.UpdateWirelessClientSplashAuthorizationStatus(plan.NetworkID.ValueString(), plan.ClientID.ValueString(), splashAuthorizationStatus(number, true))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID splash authorization", "Failed to update wireless SSID splash authorization: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + plan.ClientID.ValueString() + "/" + number)
+	authorization := status.SSIDs[number]
+	plan.AuthorizedAt = helpers.StringValueOrNull(authorization.AuthorizedAt)
+	plan.ExpiresAt = helpers.StringValueOrNull(authorization.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless SSID splash authorization resource")
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless SSID splash authorization resource")
+	var state WirelessSSIDSplashAuthorizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := w.client.GetWirelessClientSplashAuthorizationStatus(state.NetworkID.ValueString(), state.ClientID.ValueString())
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless SSID splash authorization", "Failed to get wireless SSID splash authorization: "+err.Error())
+		return
+	}
+
+	// an expired or revoked authorization is recreated on the next apply
+	authorization, ok := status.SSIDs[strconv.FormatInt(state.Number.ValueInt64(), 10)]
+	if !ok || !authorization.IsAuthorized {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.AuthorizedAt = helpers.StringValueOrNull(authorization.AuthorizedAt)
+	state.ExpiresAt = helpers.StringValueOrNull(authorization.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless SSID splash authorization resource")
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all arguments require replacement, there is nothing to update in place
+	tflog.Info(ctx, "Updating the wireless SSID splash authorization resource")
+	var plan WirelessSSIDSplashAuthorizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless SSID splash authorization resource")
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless SSID splash authorization resource")
+	var state WirelessSSIDSplashAuthorizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number := strconv.FormatInt(state.Number.ValueInt64(), 10)
+	_, err := w.client.UpdateWirelessClientSplashAuthorizationStatus(state.NetworkID.ValueString(), state.ClientID.ValueString(), splashAuthorizationStatus(number, false))
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete wireless SSID splash authorization", "Failed to delete wireless SSID splash authorization: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless SSID splash authorization resource")
+}
+
+func (w *wirelessSSIDSplashAuthorizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected import ID in the form network_id/client_id/number, got: %s", req.ID))
+		return
+	}
+	number, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("number must be a number, got: %s", parts[2]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("number"), number)...)
+}
+
+func splashAuthorizationStatus(number string, authorized bool) *meraki.WirelessClientSplashAuthorizationStatus {
+	return &meraki.WirelessClientSplashAuthorizationStatus{
+		SSIDs: map[string]meraki.WirelessSSIDSplashAuthorization{
+			number: {IsAuthorized: authorized},
+		},
+	}
+}
@@ -0,0 +1,546 @@
+package wireless
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/helpers"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ resource.Resource                   = &wirelessSSIDSplashSettingsResource{}
+	_ resource.ResourceWithConfigure      = &wirelessSSIDSplashSettingsResource{}
+	_ resource.ResourceWithImportState    = &wirelessSSIDSplashSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &wirelessSSIDSplashSettingsResource{}
+	_ resource.ResourceWithModifyPlan     = &wirelessSSIDSplashSettingsResource{}
+)
+
+// splashDurations are the durations in minutes the dashboard accepts for splash timeouts and guest access.
+var splashDurations = []int64{30, 60, 120, 240, 480, 720, 1080, 1440, 2880, 5760, 7200, 10080, 20160, 43200, 86400, 129600}
+
+// splashLogoFormats maps the file extensions of splash logos to the image formats of the API.
+var splashLogoFormats = map[string]string{
+	".png":  "png",
+	".jpg":  "jpg",
+	".jpeg": "jpg",
+	".gif":  "gif",
+}
+
+func NewWirelessSSIDSplashSettingsResource() resource.Resource {
+	return &wirelessSSIDSplashSettingsResource{}
+}
+
+type wirelessSSIDSplashSettingsResource struct {
+	client meraki.Client
+}
+
+type WirelessSSIDSplashSettingsResourceModel struct {
+	ID                          types.String           `tfsdk:"id"`
+	NetworkID                   types.String           `tfsdk:"network_id"`
+	Number                      types.Int64            `tfsdk:"number"`
+	SplashURL                   types.String           `tfsdk:"splash_url"`
+	RedirectURL                 types.String           `tfsdk:"redirect_url"`
+	WelcomeMessage              types.String           `tfsdk:"welcome_message"`
+	SplashTimeout               types.Int64            `tfsdk:"splash_timeout"`
+	BlockAllTrafficBeforeSignOn types.Bool             `tfsdk:"block_all_traffic_before_sign_on"`
+	ThemeID                     types.String           `tfsdk:"theme_id"`
+	SplashLogoPath              types.String           `tfsdk:"splash_logo_path"`
+	SplashLogoMD5               types.String           `tfsdk:"splash_logo_md5"`
+	SponsorDomains              []types.String         `tfsdk:"sponsor_domains"`
+	GuestSponsorship            *GuestSponsorshipModel `tfsdk:"guest_sponsorship"`
+	Billing                     *SplashBillingModel    `tfsdk:"billing"`
+}
+
+type GuestSponsorshipModel struct {
+	DurationInMinutes        types.Int64 `tfsdk:"duration_in_minutes"`
+	GuestCanRequestTimeframe types.Bool  `tfsdk:"guest_can_request_timeframe"`
+}
+
+type SplashBillingModel struct {
+	FreeAccessEnabled             types.Bool   `tfsdk:"free_access_enabled"`
+	FreeAccessDurationInMinutes   types.Int64  `tfsdk:"free_access_duration_in_minutes"`
+	PrepaidAccessFastLoginEnabled types.Bool   `tfsdk:"prepaid_access_fast_login_enabled"`
+	ReplyToEmailAddress           types.String `tfsdk:"reply_to_email_address"`
+}
+
+func (w *wirelessSSIDSplashSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_ssid_splash_settings"
+}
+
+func (w *wirelessSSIDSplashSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the splash page settings of an SSID. The splash page type itself is part of the SSID. " +
+			"The custom splash and redirect URLs, the welcome message and the sponsor domains are removed on destroy, the logo and theme are kept.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the SSID, between 0 and 14",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 14),
+				},
+			},
+			"splash_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of a custom splash page hosted outside of the dashboard",
+			},
+			"redirect_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL clients are redirected to after signing on, instead of the URL they requested",
+			},
+			"welcome_message": schema.StringAttribute{
+				Optional:    true,
+				Description: "The welcome message shown on the splash page",
+			},
+			"splash_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of minutes after which clients see the splash page again. Defaults to 1440",
+				Default:     int64default.StaticInt64(1440),
+				Validators: []validator.Int64{
+					int64validator.OneOf(splashDurations...),
+				},
+			},
+			"block_all_traffic_before_sign_on": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether all traffic of the clients is blocked until they sign on. Defaults to false",
+				Default:     booldefault.StaticBool(false),
+			},
+			"theme_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the splash theme",
+			},
+			"splash_logo_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of a local PNG, JPEG or GIF file uploaded as the splash logo. The logo is uploaded again when the file changes",
+			},
+			"splash_logo_md5": schema.StringAttribute{
+				Computed:    true,
+				Description: "The MD5 of the splash logo, used to detect changes of the file at splash_logo_path",
+			},
+			"sponsor_domains": schema.ListAttribute{
+				Optional:    true,
+				Description: "The email domains of the sponsors of a sponsored guest splash page",
+				ElementType: types.StringType,
+			},
+			"guest_sponsorship": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The settings of a sponsored guest splash page",
+				Attributes: map[string]schema.Attribute{
+					"duration_in_minutes": schema.Int64Attribute{
+						Required:    true,
+						Description: "The duration of the guest access granted by a sponsor in minutes",
+						Validators: []validator.Int64{
+							int64validator.OneOf(splashDurations...),
+						},
+					},
+					"guest_can_request_timeframe": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether guests can request the duration of their access. Defaults to false",
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"billing": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The settings of a billing splash page",
+				Attributes: map[string]schema.Attribute{
+					"free_access_enabled": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether clients get free access for free_access_duration_in_minutes. Defaults to false",
+						Default:     booldefault.StaticBool(false),
+					},
+					"free_access_duration_in_minutes": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The duration of the free access in minutes",
+						Validators: []validator.Int64{
+							int64validator.OneOf(splashDurations...),
+						},
+					},
+					"prepaid_access_fast_login_enabled": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether clients with prepaid access can sign on without entering their credentials again. Defaults to false",
+						Default:     booldefault.StaticBool(false),
+					},
+					"reply_to_email_address": schema.StringAttribute{
+						Optional:    true,
+						Description: "The reply-to address of the emails sent to paying clients",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessSSIDSplashSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless SSID splash settings resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless SSID splash settings resource")
+}
+
+func (w *wirelessSSIDSplashSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WirelessSSIDSplashSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SplashLogoPath.IsUnknown() && !config.SplashLogoPath.IsNull() {
+		ext := strings.ToLower(filepath.Ext(config.SplashLogoPath.ValueString()))
+		if _, ok := splashLogoFormats[ext]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("splash_logo_path"),
+				"Invalid splash logo",
+				fmt.Sprintf("the splash logo must be a .png, .jpg, .jpeg or .gif file, got: %s", config.SplashLogoPath.ValueString()),
+			)
+		}
+	}
+
+	billing := config.Billing
+	if billing != nil && !billing.FreeAccessEnabled.IsUnknown() && billing.FreeAccessEnabled.ValueBool() && billing.FreeAccessDurationInMinutes.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("billing").AtName("free_access_duration_in_minutes"),
+			"Invalid billing settings",
+			"free_access_duration_in_minutes is required when free_access_enabled is true",
+		)
+	}
+}
+
+// ModifyPlan plans the MD5 of the file at splash_logo_path, so that changes of the file
+// contents show up as a change of splash_logo_md5.
+func (w *wirelessSSIDSplashSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var logoPath types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("splash_logo_path"), &logoPath)...)
+	if resp.Diagnostics.HasError() || logoPath.IsUnknown() {
+		return
+	}
+	if logoPath.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("splash_logo_md5"), types.StringNull())...)
+		return
+	}
+
+	contents, err := os.ReadFile(logoPath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("splash_logo_path"), "Failed to read splash logo", "Failed to read splash logo: "+err.Error())
+		return
+	}
+	sum := md5.Sum(contents)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("splash_logo_md5"), hex.EncodeToString(sum[:]))...)
+}
+
+func (w *wirelessSSIDSplashSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless SSID splash settings resource")
+	var plan WirelessSSIDSplashSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(w.update(&plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + strconv.FormatInt(plan.Number.ValueInt64(), 10))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless SSID splash settings resource")
+}
+
+func (w *wirelessSSIDSplashSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless SSID splash settings resource")
+	var state WirelessSSIDSplashSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID, number := state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10)
+	settings, err := w.client.GetWirelessSSIDSplashSettings(networkID, number)
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless SSID splash settings", "Failed to get wireless SSID splash settings: "+err.Error())
+		return
+	}
+
+	ssid, err := w.client.GetWirelessSSID(networkID, number)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless SSID", "Failed to get wireless SSID: "+err.Error())
+		return
+	}
+
+	splashSettingsFromAPI(settings, &state)
+	state.SponsorDomains = sponsorDomainsFromAPI(ssid.SplashGuestSponsorDomains)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless SSID splash settings resource")
+}
+
+func (w *wirelessSSIDSplashSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless SSID splash settings resource")
+	var plan, state WirelessSSIDSplashSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(w.update(&plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless SSID splash settings resource")
+}
+
+func (w *wirelessSSIDSplashSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless SSID splash settings resource")
+	var state WirelessSSIDSplashSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID, number := state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10)
+	_, err := w.client.UpdateWirelessSSIDSplashSettings(networkID, number, &meraki.WirelessSSIDSplashSettings{
+		UseSplashURL:                false,
+		UseRedirectURL:              false,
+		WelcomeMessage:              "",
+		SplashTimeout:               1440,
+		BlockAllTrafficBeforeSignOn: false,
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete wireless SSID splash settings", "Failed to delete wireless SSID splash settings: "+err.Error())
+		return
+	}
+
+	if len(state.SponsorDomains) > 0 {
+		_, err = w.client.UpdateWirelessSSIDSplashGuestSponsorDomains(networkID, number, []string{})
+		if err != nil && !meraki.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete wireless SSID sponsor domains", "Failed to delete wireless SSID sponsor domains: "+err.Error())
+			return
+		}
+	}
+	tflog.Info(ctx, "Deleted the wireless SSID splash settings resource")
+}
+
+func (w *wirelessSSIDSplashSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSSIDState(ctx, req, resp)
+}
+
+// update applies the splash settings and the sponsor domains. The logo is only uploaded when its
+// MD5 changed from prior, which is nil on create.
+func (w *wirelessSSIDSplashSettingsResource) update(plan *WirelessSSIDSplashSettingsResourceModel, prior *WirelessSSIDSplashSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	networkID, number := plan.NetworkID.ValueString(), strconv.FormatInt(plan.Number.ValueInt64(), 10)
+
+	settings := splashSettingsToAPI(plan)
+	if !plan.SplashLogoPath.IsNull() && (prior == nil || !prior.SplashLogoMD5.Equal(plan.SplashLogoMD5)) {
+		logoPath := plan.SplashLogoPath.ValueString()
+		contents, err := os.ReadFile(logoPath)
+		if err != nil {
+			diags.AddAttributeError(path.Root("splash_logo_path"), "Failed to read splash logo", "Failed to read splash logo: "+err.Error())
+			return diags
+		}
+		settings.SplashLogo = &meraki.WirelessSSIDSplashLogo{
+			Image: &meraki.WirelessSSIDSplashImage{
+				Contents: base64.StdEncoding.EncodeToString(contents),
+				Format:   splashLogoFormats[strings.ToLower(filepath.Ext(logoPath))],
+			},
+		}
+	}
+
+	updated, err := w.client.UpdateWirelessSSIDSplashSettings(networkID, number, settings)
+	if err != nil {
+		diags.AddError("Failed to update wireless SSID splash settings", "Failed to update wireless SSID splash settings: "+err.Error())
+		return diags
+	}
+
+	var priorDomains []types.String
+	if prior != nil {
+		priorDomains = prior.SponsorDomains
+	}
+	if !equalStringValues(priorDomains, plan.SponsorDomains) {
+		domains := make([]string, 0, len(plan.SponsorDomains))
+		for _, domain := range plan.SponsorDomains {
+			domains = append(domains, domain.ValueString())
+		}
+		ssid, err := w.client.UpdateWirelessSSIDSplashGuestSponsorDomains(networkID, number, domains)
+		if err != nil {
+			diags.AddError("Failed to update wireless SSID sponsor domains", "Failed to update wireless SSID sponsor domains: "+err.Error())
+			return diags
+		}
+		plan.SponsorDomains = sponsorDomainsFromAPI(ssid.SplashGuestSponsorDomains)
+	}
+
+	splashSettingsFromAPI(updated, plan)
+	return diags
+}
+
+func splashSettingsToAPI(plan *WirelessSSIDSplashSettingsResourceModel) *meraki.WirelessSSIDSplashSettings {
+	settings := &meraki.WirelessSSIDSplashSettings{
+		SplashURL:                   plan.SplashURL.ValueString(),
+		UseSplashURL:                !plan.SplashURL.IsNull(),
+		RedirectURL:                 plan.RedirectURL.ValueString(),
+		UseRedirectURL:              !plan.RedirectURL.IsNull(),
+		WelcomeMessage:              plan.WelcomeMessage.ValueString(),
+		SplashTimeout:               plan.SplashTimeout.ValueInt64(),
+		BlockAllTrafficBeforeSignOn: plan.BlockAllTrafficBeforeSignOn.ValueBool(),
+		ThemeID:                     plan.ThemeID.ValueString(),
+	}
+	if sponsorship := plan.GuestSponsorship; sponsorship != nil {
+		settings.GuestSponsorship = &meraki.WirelessSSIDGuestSponsorship{
+			DurationInMinutes:        sponsorship.DurationInMinutes.ValueInt64(),
+			GuestCanRequestTimeframe: sponsorship.GuestCanRequestTimeframe.ValueBool(),
+		}
+	}
+	if billing := plan.Billing; billing != nil {
+		settings.Billing = &meraki.WirelessSSIDBilling{
+			FreeAccess: &meraki.WirelessSSIDBillingFreeAccess{
+				Enabled:           billing.FreeAccessEnabled.ValueBool(),
+				DurationInMinutes: billing.FreeAccessDurationInMinutes.ValueInt64(),
+			},
+			PrepaidAccessFastLoginEnabled: billing.PrepaidAccessFastLoginEnabled.ValueBool(),
+			ReplyToEmailAddress:           billing.ReplyToEmailAddress.ValueString(),
+		}
+	}
+	return settings
+}
+
+func splashSettingsFromAPI(settings *meraki.WirelessSSIDSplashSettings, state *WirelessSSIDSplashSettingsResourceModel) {
+	state.SplashURL = types.StringNull()
+	if settings.UseSplashURL {
+		state.SplashURL = helpers.StringValueOrNull(settings.SplashURL)
+	}
+	state.RedirectURL = types.StringNull()
+	if settings.UseRedirectURL {
+		state.RedirectURL = helpers.StringValueOrNull(settings.RedirectURL)
+	}
+	state.WelcomeMessage = helpers.StringValueOrNull(settings.WelcomeMessage)
+	state.SplashTimeout = types.Int64Value(settings.SplashTimeout)
+	state.BlockAllTrafficBeforeSignOn = types.BoolValue(settings.BlockAllTrafficBeforeSignOn)
+
+	// the dashboard always reports a theme, the logo and the guest sponsorship and billing
+	// settings, only track them when configured
+	if !state.ThemeID.IsNull() {
+		state.ThemeID = helpers.StringValueOrNull(settings.ThemeID)
+	}
+	// the planned MD5 is the one of the local file, the MD5 the API reports is only used to detect
+	// that the logo was removed, so that it is uploaded again
+	switch {
+	case state.SplashLogoPath.IsNull() || settings.SplashLogo == nil || settings.SplashLogo.MD5 == "":
+		state.SplashLogoMD5 = types.StringNull()
+	case state.SplashLogoMD5.IsNull() || state.SplashLogoMD5.IsUnknown():
+		state.SplashLogoMD5 = types.StringValue(settings.SplashLogo.MD5)
+	}
+	if state.GuestSponsorship != nil && settings.GuestSponsorship != nil {
+		state.GuestSponsorship = &GuestSponsorshipModel{
+			DurationInMinutes:        types.Int64Value(settings.GuestSponsorship.DurationInMinutes),
+			GuestCanRequestTimeframe: types.BoolValue(settings.GuestSponsorship.GuestCanRequestTimeframe),
+		}
+	}
+	if state.Billing != nil && settings.Billing != nil {
+		billing := &SplashBillingModel{
+			FreeAccessEnabled:             types.BoolValue(false),
+			FreeAccessDurationInMinutes:   types.Int64Null(),
+			PrepaidAccessFastLoginEnabled: types.BoolValue(settings.Billing.PrepaidAccessFastLoginEnabled),
+			ReplyToEmailAddress:           helpers.StringValueOrNull(settings.Billing.ReplyToEmailAddress),
+		}
+		if free := settings.Billing.FreeAccess; free != nil {
+			billing.FreeAccessEnabled = types.BoolValue(free.Enabled)
+			if !state.Billing.FreeAccessDurationInMinutes.IsNull() && free.DurationInMinutes != 0 {
+				billing.FreeAccessDurationInMinutes = types.Int64Value(free.DurationInMinutes)
+			}
+		}
+		state.Billing = billing
+	}
+}
+
+func sponsorDomainsFromAPI(domains []string) []types.String {
+	var result []types.String
+	for _, domain := range domains {
+		result = append(result, types.StringValue(domain))
+	}
+	return result
+}
+
+func equalStringValues(a, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
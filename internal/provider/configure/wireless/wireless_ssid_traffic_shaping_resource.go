@@ -0,0 +1,430 @@
+package wireless
+
+import (
+	"context"
+	"fmt"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+)
+
+var (
+	_ resource.Resource                   = &wirelessSSIDTrafficShapingResource{}
+	_ resource.ResourceWithConfigure      = &wirelessSSIDTrafficShapingResource{}
+	_ resource.ResourceWithImportState    = &wirelessSSIDTrafficShapingResource{}
+	_ resource.ResourceWithValidateConfig = &wirelessSSIDTrafficShapingResource{}
+)
+
+var dscpTagValues = []int64{0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56}
+
+func NewWirelessSSIDTrafficShapingResource() resource.Resource {
+	return &wirelessSSIDTrafficShapingResource{}
+}
+
+type wirelessSSIDTrafficShapingResource struct {
+	client meraki.Client
+}
+
+type WirelessSSIDTrafficShapingResourceModel struct {
+	ID                    types.String                          `tfsdk:"id"`
+	NetworkID             types.String                          `tfsdk:"network_id"`
+	Number                types.Int64                           `tfsdk:"number"`
+	TrafficShapingEnabled types.Bool                            `tfsdk:"traffic_shaping_enabled"`
+	DefaultRulesEnabled   types.Bool                            `tfsdk:"default_rules_enabled"`
+	Rules                 []WirelessSSIDTrafficShapingRuleModel `tfsdk:"rules"`
+}
+
+type WirelessSSIDTrafficShapingRuleModel struct {
+	Definitions              []TrafficShapingDefinitionModel `tfsdk:"definitions"`
+	PerClientBandwidthLimits *PerClientBandwidthLimitsModel  `tfsdk:"per_client_bandwidth_limits"`
+	DscpTagValue             types.Int64                     `tfsdk:"dscp_tag_value"`
+	PcpTagValue              types.Int64                     `tfsdk:"pcp_tag_value"`
+}
+
+type TrafficShapingDefinitionModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type PerClientBandwidthLimitsModel struct {
+	Settings        types.String         `tfsdk:"settings"`
+	BandwidthLimits *BandwidthLimitModel `tfsdk:"bandwidth_limits"`
+}
+
+type BandwidthLimitModel struct {
+	LimitUp   types.Int64 `tfsdk:"limit_up"`
+	LimitDown types.Int64 `tfsdk:"limit_down"`
+}
+
+func (w *wirelessSSIDTrafficShapingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wireless_ssid_traffic_shaping"
+}
+
+func (w *wirelessSSIDTrafficShapingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the traffic shaping settings and the complete, ordered list of traffic shaping rules of an SSID. All rules are removed and the default rules are enabled on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource in the form network_id/number",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the network",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of the SSID, between 0 and 14",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 14),
+				},
+			},
+			"traffic_shaping_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether traffic shaping is enabled on the SSID. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"default_rules_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the Meraki default traffic shaping rules are applied. Defaults to true",
+				Default:     booldefault.StaticBool(true),
+			},
+			"rules": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The traffic shaping rules in the order they are evaluated",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"definitions": schema.ListNestedAttribute{
+							Required:    true,
+							Description: "The traffic the rule applies to, traffic matching any of the definitions is shaped",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "The type of the definition, can be 'application', 'applicationCategory', 'host', 'port', 'ipRange' or 'localNet'",
+										Validators: []validator.String{
+											stringvalidator.OneOf("application", "applicationCategory", "host", "port", "ipRange", "localNet"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Required:    true,
+										Description: "The host, port, IP range or local network, or the ID of the application or application category, e.g. 'meraki:layer7/application/67'",
+									},
+								},
+							},
+						},
+						"per_client_bandwidth_limits": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "The per-client bandwidth limits of the matched traffic. The network default applies when not set",
+							Attributes: map[string]schema.Attribute{
+								"settings": schema.StringAttribute{
+									Required:    true,
+									Description: "How the limits are applied, can be 'network default', 'ignore' or 'custom'",
+									Validators: []validator.String{
+										stringvalidator.OneOf("network default", "ignore", "custom"),
+									},
+								},
+								"bandwidth_limits": schema.SingleNestedAttribute{
+									Optional:    true,
+									Description: "The custom limits, required when settings is 'custom'",
+									Attributes: map[string]schema.Attribute{
+										"limit_up": schema.Int64Attribute{
+											Required:    true,
+											Description: "The upload limit in Kbps",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+										"limit_down": schema.Int64Attribute{
+											Required:    true,
+											Description: "The download limit in Kbps",
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+											},
+										},
+									},
+								},
+							},
+						},
+						"dscp_tag_value": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The DSCP tag applied to the matched traffic. The tag is left unchanged when not set",
+							Validators: []validator.Int64{
+								int64validator.OneOf(dscpTagValues...),
+							},
+						},
+						"pcp_tag_value": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The 802.1p PCP tag applied to the matched traffic, between 0 and 7. The tag is left unchanged when not set",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 7),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (w *wirelessSSIDTrafficShapingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring the wireless SSID traffic shaping resource")
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(meraki.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"invalid provider data",
+			fmt.Sprintf("expected *meraki.Client, got %T. Please report this bug to the provider developer", req.ProviderData),
+		)
+		return
+	}
+
+	w.client = client
+	tflog.Info(ctx, "Configured the wireless SSID traffic shaping resource")
+}
+
+func (w *wirelessSSIDTrafficShapingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WirelessSSIDTrafficShapingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, rule := range config.Rules {
+		limits := rule.PerClientBandwidthLimits
+		if limits == nil || limits.Settings.IsUnknown() {
+			continue
+		}
+		custom := limits.Settings.ValueString() == "custom"
+		if custom != (limits.BandwidthLimits != nil) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rules").AtListIndex(i).AtName("per_client_bandwidth_limits").AtName("bandwidth_limits"),
+				"Invalid traffic shaping rule",
+				fmt.Sprintf("bandwidth_limits must be set if and only if settings is 'custom', got settings %q", limits.Settings.ValueString()),
+			)
+		}
+	}
+}
+
+func (w *wirelessSSIDTrafficShapingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating the wireless SSID traffic shaping resource")
+	var plan WirelessSSIDTrafficShapingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	number := strconv.FormatInt(plan.Number.ValueInt64(), 10)
+	rules, err := w.client.UpdateWirelessSSIDTrafficShapingRules(plan.NetworkID.ValueString(), number, ssidTrafficShapingToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID traffic shaping", "Failed to update wireless SSID traffic shaping: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString() + "/" + number)
+	ssidTrafficShapingFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Created the wireless SSID traffic shaping resource")
+}
+
+func (w *wirelessSSIDTrafficShapingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading the wireless SSID traffic shaping resource")
+	var state WirelessSSIDTrafficShapingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := w.client.GetWirelessSSIDTrafficShapingRules(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10))
+	if meraki.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get wireless SSID traffic shaping", "Failed to get wireless SSID traffic shaping: "+err.Error())
+		return
+	}
+
+	ssidTrafficShapingFromAPI(rules, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Readed the wireless SSID traffic shaping resource")
+}
+
+func (w *wirelessSSIDTrafficShapingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating the wireless SSID traffic shaping resource")
+	var plan WirelessSSIDTrafficShapingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := w.client.UpdateWirelessSSIDTrafficShapingRules(plan.NetworkID.ValueString(), strconv.FormatInt(plan.Number.ValueInt64(), 10), ssidTrafficShapingToAPI(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update wireless SSID traffic shaping", "Failed to update wireless SSID traffic shaping: "+err.Error())
+		return
+	}
+
+	ssidTrafficShapingFromAPI(rules, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updated the wireless SSID traffic shaping resource")
+}
+
+func (w *wirelessSSIDTrafficShapingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting the wireless SSID traffic shaping resource")
+	var state WirelessSSIDTrafficShapingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := w.client.UpdateWirelessSSIDTrafficShapingRules(state.NetworkID.ValueString(), strconv.FormatInt(state.Number.ValueInt64(), 10), &meraki.WirelessSSIDTrafficShapingRules{
+		TrafficShapingEnabled: true,
+		DefaultRulesEnabled:   true,
+		Rules:                 []meraki.WirelessSSIDTrafficShapingRule{},
+	})
+	if err != nil && !meraki.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete wireless SSID traffic shaping", "Failed to delete wireless SSID traffic shaping: "+err.Error())
+		return
+	}
+	tflog.Info(ctx, "Deleted the wireless SSID traffic shaping resource")
+}
+
+func (w *wirelessSSIDTrafficShapingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSSIDState(ctx, req, resp)
+}
+
+func ssidTrafficShapingToAPI(plan *WirelessSSIDTrafficShapingResourceModel) *meraki.WirelessSSIDTrafficShapingRules {
+	result := &meraki.WirelessSSIDTrafficShapingRules{
+		TrafficShapingEnabled: plan.TrafficShapingEnabled.ValueBool(),
+		DefaultRulesEnabled:   plan.DefaultRulesEnabled.ValueBool(),
+		Rules:                 make([]meraki.WirelessSSIDTrafficShapingRule, 0, len(plan.Rules)),
+	}
+	for _, rule := range plan.Rules {
+		r := meraki.WirelessSSIDTrafficShapingRule{
+			Definitions: make([]meraki.ApplianceTrafficShapingDefinition, 0, len(rule.Definitions)),
+		}
+		for _, definition := range rule.Definitions {
+			r.Definitions = append(r.Definitions, meraki.ApplianceTrafficShapingDefinition{
+				Type:  definition.Type.ValueString(),
+				Value: definition.Value.ValueString(),
+			})
+		}
+		if limits := rule.PerClientBandwidthLimits; limits != nil {
+			r.PerClientBandwidthLimits = &meraki.ApplianceTrafficShapingPerClientBandwidthLimits{
+				Settings: limits.Settings.ValueString(),
+			}
+			if limits.BandwidthLimits != nil {
+				up, down := limits.BandwidthLimits.LimitUp.ValueInt64(), limits.BandwidthLimits.LimitDown.ValueInt64()
+				r.PerClientBandwidthLimits.BandwidthLimits = &meraki.ApplianceBandwidthLimit{
+					LimitUp:   &up,
+					LimitDown: &down,
+				}
+			}
+		}
+		if !rule.DscpTagValue.IsNull() && !rule.DscpTagValue.IsUnknown() {
+			dscp := rule.DscpTagValue.ValueInt64()
+			r.DscpTagValue = &dscp
+		}
+		if !rule.PcpTagValue.IsNull() && !rule.PcpTagValue.IsUnknown() {
+			pcp := rule.PcpTagValue.ValueInt64()
+			r.PcpTagValue = &pcp
+		}
+		result.Rules = append(result.Rules, r)
+	}
+	return result
+}
+
+func ssidTrafficShapingFromAPI(rules *meraki.WirelessSSIDTrafficShapingRules, state *WirelessSSIDTrafficShapingResourceModel) {
+	state.TrafficShapingEnabled = types.BoolValue(rules.TrafficShapingEnabled)
+	state.DefaultRulesEnabled = types.BoolValue(rules.DefaultRulesEnabled)
+
+	var result []WirelessSSIDTrafficShapingRuleModel
+	for i, rule := range rules.Rules {
+		var previous WirelessSSIDTrafficShapingRuleModel
+		if i < len(state.Rules) {
+			previous = state.Rules[i]
+		}
+
+		model := WirelessSSIDTrafficShapingRuleModel{
+			Definitions:  make([]TrafficShapingDefinitionModel, 0, len(rule.Definitions)),
+			DscpTagValue: types.Int64Null(),
+			PcpTagValue:  types.Int64Null(),
+		}
+		for _, definition := range rule.Definitions {
+			model.Definitions = append(model.Definitions, TrafficShapingDefinitionModel{
+				Type:  types.StringValue(definition.Type),
+				Value: types.StringValue(definition.Value),
+			})
+		}
+		if rule.DscpTagValue != nil {
+			model.DscpTagValue = types.Int64Value(*rule.DscpTagValue)
+		}
+		if rule.PcpTagValue != nil {
+			model.PcpTagValue = types.Int64Value(*rule.PcpTagValue)
+		}
+
+		// the API reports the network default explicitly, only track it when it was configured
+		limits := rule.PerClientBandwidthLimits
+		if limits != nil && (previous.PerClientBandwidthLimits != nil || limits.Settings != "network default") {
+			model.PerClientBandwidthLimits = &PerClientBandwidthLimitsModel{
+				Settings: types.StringValue(limits.Settings),
+			}
+			if limits.Settings == "custom" && limits.BandwidthLimits != nil {
+				model.PerClientBandwidthLimits.BandwidthLimits = &BandwidthLimitModel{
+					LimitUp:   types.Int64Value(0),
+					LimitDown: types.Int64Value(0),
+				}
+				if limits.BandwidthLimits.LimitUp != nil {
+					model.PerClientBandwidthLimits.BandwidthLimits.LimitUp = types.Int64Value(*limits.BandwidthLimits.LimitUp)
+				}
+				if limits.BandwidthLimits.LimitDown != nil {
+					model.PerClientBandwidthLimits.BandwidthLimits.LimitDown = types.Int64Value(*limits.BandwidthLimits.LimitDown)
+				}
+			}
+		}
+
+		result = append(result, model)
+	}
+	state.Rules = result
+}
@@ -0,0 +1,150 @@
+// Package helpers contains the code shared by the resources and data sources of all product packages,
+// such as the conversions between Terraform and Meraki API values.
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SplitImportID splits a composite import ID such as "network_id/vlan_id" into its two parts.
+func SplitImportID(id string, format string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected import ID in the form %s, got: %s", format, id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// StringValueOrNull maps the empty strings the API returns for unset fields to null.
+func StringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// ToStringValues converts the values the API returns, an empty list when there are none. Wrap it in
+// NilIfEmpty for optional lists that stay null in state.
+func ToStringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
+
+// FromStringValues converts the values of a list for the API, an empty list when there are none so
+// that the API clears the field.
+func FromStringValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+	return result
+}
+
+// NilIfEmpty keeps optional lists null in state when the API returns no elements.
+func NilIfEmpty(values []types.String) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// KeepCase returns the prior value when it only differs from the API value in case.
+func KeepCase(prior types.String, value string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && strings.EqualFold(prior.ValueString(), value) {
+		return prior
+	}
+	return types.StringValue(value)
+}
+
+// KeepCaseValues converts the values of an optional list, keeping the prior value of elements that
+// only differ in case, e.g. MAC addresses the API returns in lower case. The list stays null in state
+// when the API returns no elements.
+func KeepCaseValues(prior []types.String, values []string) []types.String {
+	var result []types.String
+	for i, v := range values {
+		if i < len(prior) {
+			result = append(result, KeepCase(prior[i], v))
+			continue
+		}
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
+
+// Int64PointerOrNil maps a null or unknown value to nil for optional API fields.
+func Int64PointerOrNil(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := value.ValueInt64()
+	return &v
+}
+
+// Int64ValueOrNull maps the nil the API returns for unset numbers to null.
+func Int64ValueOrNull(value *int64) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*value)
+}
+
+// SetToStrings converts a set of strings into a Go slice, leaving target untouched
+// when the set is null or unknown.
+func SetToStrings(ctx context.Context, set types.Set, target *[]string) diag.Diagnostics {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	return set.ElementsAs(ctx, target, false)
+}
+
+// SetFromStrings converts the values the API returns into a set of strings. The set stays null when
+// it was null in prior and the API returns no elements, and an empty set stays empty.
+func SetFromStrings(ctx context.Context, prior types.Set, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// KeepSecrets copies the secrets of prior into the matching elements of values, as the API never
+// returns them. Elements are matched by key, the secret of elements that are not in prior is null.
+func KeepSecrets[T any](prior []T, values []T, key func(T) string, secret func(*T) *types.String) []T {
+	secrets := make(map[string]types.String, len(prior))
+	for i := range prior {
+		secrets[key(prior[i])] = *secret(&prior[i])
+	}
+	for i := range values {
+		value, ok := secrets[key(values[i])]
+		if !ok {
+			value = types.StringNull()
+		}
+		*secret(&values[i]) = value
+	}
+	return values
+}
+
+// Difference returns the elements of a that are not in b.
+func Difference(a, b []string) []string {
+	seen := make(map[string]bool, len(b))
+	for _, v := range b {
+		seen[v] = true
+	}
+	var diff []string
+	for _, v := range a {
+		if !seen[v] {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Reconciler makes a collection of API objects that are created, updated and deleted one by one match
+// the planned objects, such as the QoS rules of a network.
+type Reconciler[T any] struct {
+	// Name is the name of a single object in diagnostics, e.g. "switch QoS rule".
+	Name string
+	// ID returns the ID of an existing object.
+	ID func(object T) string
+	// Same reports whether an existing object already has the content of a planned object.
+	Same   func(existing T, planned T) bool
+	Create func(object *T) (*T, error)
+	Update func(id string, object *T) (*T, error)
+	Delete func(id string) error
+}
+
+// Reconcile keeps the existing objects with the same content as a planned object, updates the
+// remaining existing objects in place before creating new ones, and deletes the surplus objects. It
+// returns the IDs of the resulting objects in the order of planned.
+func (r Reconciler[T]) Reconcile(ctx context.Context, existing []T, planned []T) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ids := make([]string, len(planned))
+	used := make([]bool, len(existing))
+	for i := range planned {
+		for j, current := range existing {
+			if !used[j] && r.Same(current, planned[i]) {
+				used[j] = true
+				ids[i] = r.ID(current)
+				break
+			}
+		}
+	}
+
+	var leftover []string
+	for j, current := range existing {
+		if !used[j] {
+			leftover = append(leftover, r.ID(current))
+		}
+	}
+
+	for i := range planned {
+		if ids[i] != "" {
+			continue
+		}
+		if len(leftover) > 0 {
+			tflog.Debug(ctx, "Updating "+r.Name, map[string]interface{}{"id": leftover[0]})
+			updated, err := r.Update(leftover[0], &planned[i])
+			if err != nil {
+				diags.AddError("Failed to update "+r.Name, "Failed to update "+r.Name+": "+err.Error())
+				return nil, diags
+			}
+			ids[i] = r.ID(*updated)
+			leftover = leftover[1:]
+			continue
+		}
+		created, err := r.Create(&planned[i])
+		if err != nil {
+			diags.AddError("Failed to create "+r.Name, "Failed to create "+r.Name+": "+err.Error())
+			return nil, diags
+		}
+		ids[i] = r.ID(*created)
+	}
+
+	for _, id := range leftover {
+		tflog.Debug(ctx, "Deleting "+r.Name, map[string]interface{}{"id": id})
+		err := r.Delete(id)
+		if err != nil && !meraki.IsNotFound(err) {
+			diags.AddError("Failed to delete "+r.Name, "Failed to delete "+r.Name+": "+err.Error())
+			return nil, diags
+		}
+	}
+	return ids, diags
+}
@@ -2,8 +2,12 @@ package provider
 
 import (
 	"context"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/configure/appliances"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/configure/devices"
 	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/configure/networks"
 	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/configure/organizations"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/configure/switches"
+	"github.com/a60814billy/terraform-provider-cisco-meraki/internal/provider/configure/wireless"
 	"github.com/a60814billy/terraform-provider-cisco-meraki/meraki"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -66,11 +70,62 @@ func (p *ciscoMerakiProvider) DataSources(ctx context.Context) []func() datasour
 	return []func() datasource.DataSource{
 		organizations.NewOrganizationsDataSource,
 		organizations.NewOrganizationDataSource,
+		organizations.NewOrganizationInventoryDataSource,
+		devices.NewDevicesDataSource,
+		devices.NewDeviceStatusesDataSource,
+		appliances.NewApplianceL7ApplicationCategoriesDataSource,
+		appliances.NewApplianceContentFilteringCategoriesDataSource,
 	}
 }
 
 func (p *ciscoMerakiProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		networks.NewNetworkResource,
+		organizations.NewOrganizationInventoryClaimResource,
+		organizations.NewOrganizationThirdPartyVPNPeersResource,
+		appliances.NewApplianceVLANResource,
+		appliances.NewApplianceVLANsSettingsResource,
+		appliances.NewApplianceStaticRouteResource,
+		appliances.NewAppliancePortResource,
+		appliances.NewApplianceL3FirewallRulesResource,
+		appliances.NewApplianceInboundFirewallRulesResource,
+		appliances.NewApplianceCellularFirewallRulesResource,
+		appliances.NewApplianceL7FirewallRulesResource,
+		appliances.NewAppliancePortForwardingRulesResource,
+		appliances.NewApplianceOneToOneNatRulesResource,
+		appliances.NewApplianceOneToManyNatRulesResource,
+		appliances.NewApplianceSiteToSiteVPNResource,
+		appliances.NewApplianceUplinkBandwidthResource,
+		appliances.NewApplianceTrafficShapingRulesResource,
+		appliances.NewApplianceUplinkSelectionResource,
+		appliances.NewApplianceContentFilteringResource,
+		appliances.NewApplianceSecurityResource,
+		wireless.NewWirelessSSIDResource,
+		wireless.NewWirelessSSIDL3FirewallRulesResource,
+		wireless.NewWirelessSSIDL7FirewallRulesResource,
+		wireless.NewWirelessSSIDTrafficShapingResource,
+		wireless.NewWirelessSSIDSplashSettingsResource,
+		wireless.NewWirelessSSIDSplashAuthorizationResource,
+		wireless.NewWirelessRfProfileResource,
+		wireless.NewWirelessSettingsResource,
+		wireless.NewWirelessBluetoothSettingsResource,
+		wireless.NewWirelessBluetoothBeaconResource,
+		switches.NewSwitchPortResource,
+		switches.NewSwitchPortsResource,
+		switches.NewSwitchACLResource,
+		switches.NewSwitchQosRulesResource,
+		switches.NewSwitchDscpToCosMappingsResource,
+		switches.NewSwitchStackResource,
+		switches.NewSwitchStackRoutingInterfaceResource,
+		switches.NewSwitchStackStaticRouteResource,
+		switches.NewSwitchRoutingInterfaceResource,
+		switches.NewSwitchStaticRouteResource,
+		switches.NewSwitchRoutingOspfResource,
+		switches.NewSwitchStpResource,
+		switches.NewSwitchMtuResource,
+		switches.NewSwitchStormControlResource,
+		switches.NewSwitchSettingsResource,
+		switches.NewSwitchAccessPolicyResource,
+		switches.NewSwitchDhcpServerPolicyResource,
 	}
 }
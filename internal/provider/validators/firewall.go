@@ -0,0 +1,151 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = firewallAddressesValidator{}
+	_ validator.String = portsValidator{}
+
+	// matches dashboard object references such as VLAN(10).*, VLAN(10).5, OBJ(123) or GRP(456)
+	firewallObjectPattern = regexp.MustCompile(`^(VLAN\(\d+\)\.(\*|\d+)|OBJ\(\d+\)|GRP\(\d+\))$`)
+)
+
+type firewallAddressesValidator struct {
+	objects bool
+	single  bool
+}
+
+// FirewallAddresses returns a validator for firewall source and destination fields: either "Any" or a
+// comma separated list of IPs, CIDRs, VLAN(x).y references and network object/group references.
+func FirewallAddresses() validator.String {
+	return firewallAddressesValidator{objects: true}
+}
+
+// FirewallCidrs returns a validator for firewall fields that do not support object references:
+// either "Any" or a comma separated list of IPs and CIDRs.
+func FirewallCidrs() validator.String {
+	return firewallAddressesValidator{}
+}
+
+// FirewallCidr returns a validator for firewall fields that take a single address:
+// either "Any", an IP or a CIDR.
+func FirewallCidr() validator.String {
+	return firewallAddressesValidator{single: true}
+}
+
+func (v firewallAddressesValidator) Description(ctx context.Context) string {
+	if v.single {
+		return "value must be 'Any', an IP address or a CIDR"
+	}
+	if !v.objects {
+		return "value must be 'Any' or a comma separated list of IP addresses and CIDRs"
+	}
+	return "value must be 'Any' or a comma separated list of IP addresses, CIDRs or VLAN/OBJ/GRP references"
+}
+
+func (v firewallAddressesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v firewallAddressesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if strings.EqualFold(value, "any") {
+		return
+	}
+
+	entries := strings.Split(value, ",")
+	if v.single && len(entries) > 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid firewall address",
+			fmt.Sprintf("%s, got a list: %s", v.Description(ctx), value),
+		)
+		return
+	}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if net.ParseIP(entry) != nil || (v.objects && firewallObjectPattern.MatchString(entry)) {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(entry); err == nil {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid firewall address",
+			fmt.Sprintf("%s, got invalid entry %q in: %s", v.Description(ctx), entry, value),
+		)
+		return
+	}
+}
+
+type portsValidator struct{}
+
+// Ports returns a validator for port fields: either "Any" or a comma separated list of
+// ports and port ranges between 1 and 65535, e.g. "80,443,8000-8080".
+func Ports() validator.String {
+	return portsValidator{}
+}
+
+func (v portsValidator) Description(ctx context.Context) string {
+	return "value must be 'Any' or a comma separated list of ports and port ranges between 1 and 65535"
+}
+
+func (v portsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portsValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if strings.EqualFold(value, "any") {
+		return
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		if err := validatePortRange(strings.TrimSpace(entry)); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid port",
+				fmt.Sprintf("%s, got: %s (%s)", v.Description(ctx), value, err),
+			)
+			return
+		}
+	}
+}
+
+func validatePortRange(entry string) error {
+	bounds := strings.Split(entry, "-")
+	if len(bounds) > 2 {
+		return fmt.Errorf("invalid port range %q", entry)
+	}
+
+	ports := make([]int, 0, len(bounds))
+	for _, bound := range bounds {
+		port, err := strconv.Atoi(strings.TrimSpace(bound))
+		if err != nil || port < 1 || port > 65535 {
+			return fmt.Errorf("invalid port %q", bound)
+		}
+		ports = append(ports, port)
+	}
+	if len(ports) == 2 && ports[0] > ports[1] {
+		return fmt.Errorf("port range %q starts after it ends", entry)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFirewallCidr(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "any",
+			value: types.StringValue("any"),
+		},
+		{
+			name:  "IPv4 address",
+			value: types.StringValue("10.0.0.1"),
+		},
+		{
+			name:  "IPv6 CIDR",
+			value: types.StringValue("2001:db8::/32"),
+		},
+		{
+			name:    "list of CIDRs",
+			value:   types.StringValue("10.0.0.0/8,192.168.0.0/16"),
+			wantErr: true,
+		},
+		{
+			name:    "object reference",
+			value:   types.StringValue("VLAN(10).*"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("src_cidr"),
+				ConfigValue: tt.value,
+			}
+			var resp validator.StringResponse
+			FirewallCidr().ValidateString(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("FirewallCidr() on %s: errors = %v, wantErr %v", tt.value, resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = ipAddressValidator{}
+	_ validator.String = cidrValidator{}
+	_ validator.String = allowedIPValidator{}
+)
+
+type ipAddressValidator struct {
+	ipv6 bool
+}
+
+// IPv4Address returns a validator which ensures the value is a valid IPv4 address.
+func IPv4Address() validator.String {
+	return ipAddressValidator{}
+}
+
+// IPv6Address returns a validator which ensures the value is a valid IPv6 address.
+func IPv6Address() validator.String {
+	return ipAddressValidator{ipv6: true}
+}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	if v.ipv6 {
+		return "value must be a valid IPv6 address"
+	}
+	return "value must be a valid IPv4 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := net.ParseIP(value)
+	if ip == nil || (ip.To4() != nil) == v.ipv6 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP address",
+			fmt.Sprintf("%s, got: %s", v.Description(ctx), value),
+		)
+	}
+}
+
+type cidrValidator struct {
+	ipv6 bool
+	any  bool
+}
+
+// IPv4CIDR returns a validator which ensures the value is an IPv4 network in CIDR notation, e.g. "192.168.1.0/24".
+func IPv4CIDR() validator.String {
+	return cidrValidator{}
+}
+
+// IPv6CIDR returns a validator which ensures the value is an IPv6 network in CIDR notation.
+func IPv6CIDR() validator.String {
+	return cidrValidator{ipv6: true}
+}
+
+// CIDR returns a validator which ensures the value is an IPv4 or IPv6 network in CIDR notation.
+func CIDR() validator.String {
+	return cidrValidator{any: true}
+}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	switch {
+	case v.any:
+		return "value must be a network in CIDR notation"
+	case v.ipv6:
+		return "value must be an IPv6 network in CIDR notation"
+	default:
+		return "value must be an IPv4 network in CIDR notation"
+	}
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip, _, err := net.ParseCIDR(value)
+	if err == nil && !v.any && (ip.To4() != nil) == v.ipv6 {
+		err = fmt.Errorf("wrong address family")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%s, got: %s", v.Description(ctx), value),
+		)
+	}
+}
+
+type allowedIPValidator struct{}
+
+// AllowedIP returns a validator for allowed remote IP entries: either "any" or an IPv4 address or network in CIDR notation.
+func AllowedIP() validator.String {
+	return allowedIPValidator{}
+}
+
+func (v allowedIPValidator) Description(ctx context.Context) string {
+	return "value must be 'any' or an IPv4 address or network in CIDR notation"
+}
+
+func (v allowedIPValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v allowedIPValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if strings.EqualFold(value, "any") {
+		return
+	}
+	if ip := net.ParseIP(value); ip != nil && ip.To4() != nil {
+		return
+	}
+	if ip, _, err := net.ParseCIDR(value); err == nil && ip.To4() != nil {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid allowed IP",
+		fmt.Sprintf("%s, got: %s", v.Description(ctx), value),
+	)
+}
@@ -0,0 +1,41 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = macAddressValidator{}
+
+type macAddressValidator struct{}
+
+// MACAddress returns a validator which ensures the value is a MAC address, e.g. "aa:bb:cc:dd:ee:ff".
+func MACAddress() validator.String {
+	return macAddressValidator{}
+}
+
+func (v macAddressValidator) Description(ctx context.Context) string {
+	return "value must be a MAC address"
+}
+
+func (v macAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v macAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if mac, err := net.ParseMAC(value); err != nil || len(mac) != 6 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MAC address",
+			fmt.Sprintf("%s, got: %s", v.Description(ctx), value),
+		)
+	}
+}
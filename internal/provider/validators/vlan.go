@@ -0,0 +1,88 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = vlanListValidator{}
+
+type vlanListValidator struct{}
+
+// VlanList returns a validator which ensures the value is "all" or a comma separated list of VLAN IDs
+// and ranges, e.g. "1,3,5-10".
+func VlanList() validator.String {
+	return vlanListValidator{}
+}
+
+func (v vlanListValidator) Description(ctx context.Context) string {
+	return "value must be 'all' or a comma separated list of VLAN IDs and ranges between 1 and 4094"
+}
+
+func (v vlanListValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v vlanListValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseVlanList(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid VLAN list",
+			fmt.Sprintf("%s: %s", v.Description(ctx), err),
+		)
+	}
+}
+
+// VlanRange is an inclusive range of VLAN IDs, a single VLAN ID starts and ends at the same ID.
+type VlanRange struct {
+	Start, End int64
+}
+
+// ParseVlanList parses a list of VLANs such as "1,3,5-10". "all" is returned as the full 1-4094 range.
+func ParseVlanList(value string) ([]VlanRange, error) {
+	if strings.EqualFold(strings.TrimSpace(value), "all") {
+		return []VlanRange{{1, 4094}}, nil
+	}
+
+	var result []VlanRange
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		bounds := strings.Split(entry, "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("invalid VLAN range %q", entry)
+		}
+
+		ids := make([]int64, 0, len(bounds))
+		for _, bound := range bounds {
+			id, err := strconv.ParseInt(strings.TrimSpace(bound), 10, 64)
+			if err != nil || id < 1 || id > 4094 {
+				return nil, fmt.Errorf("invalid VLAN ID %q", bound)
+			}
+			ids = append(ids, id)
+		}
+		r := VlanRange{ids[0], ids[len(ids)-1]}
+		if r.Start > r.End {
+			return nil, fmt.Errorf("VLAN range %q starts after it ends", entry)
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// VlanListContains reports whether the VLAN ID is in one of the ranges.
+func VlanListContains(ranges []VlanRange, id int64) bool {
+	for _, r := range ranges {
+		if id >= r.Start && id <= r.End {
+			return true
+		}
+	}
+	return false
+}
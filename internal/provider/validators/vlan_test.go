@@ -0,0 +1,179 @@
+package validators
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseVlanList(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []VlanRange
+		wantErr bool
+	}{
+		{
+			name:  "all",
+			value: "all",
+			want:  []VlanRange{{1, 4094}},
+		},
+		{
+			name:  "all in upper case",
+			value: " ALL ",
+			want:  []VlanRange{{1, 4094}},
+		},
+		{
+			name:  "single VLAN",
+			value: "10",
+			want:  []VlanRange{{10, 10}},
+		},
+		{
+			name:  "VLANs and ranges",
+			value: "1,3,5-10",
+			want:  []VlanRange{{1, 1}, {3, 3}, {5, 10}},
+		},
+		{
+			name:  "spaces around entries and bounds",
+			value: " 1 , 5 - 10 ",
+			want:  []VlanRange{{1, 1}, {5, 10}},
+		},
+		{
+			name:  "range of a single VLAN",
+			value: "7-7",
+			want:  []VlanRange{{7, 7}},
+		},
+		{
+			name:  "bounds of the VLAN IDs",
+			value: "1-4094",
+			want:  []VlanRange{{1, 4094}},
+		},
+		{
+			name:    "empty",
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "empty entry",
+			value:   "1,,3",
+			wantErr: true,
+		},
+		{
+			name:    "VLAN ID 0",
+			value:   "0",
+			wantErr: true,
+		},
+		{
+			name:    "VLAN ID above 4094",
+			value:   "1-4095",
+			wantErr: true,
+		},
+		{
+			name:    "reversed range",
+			value:   "10-5",
+			wantErr: true,
+		},
+		{
+			name:    "range with three bounds",
+			value:   "1-5-10",
+			wantErr: true,
+		},
+		{
+			name:    "not a number",
+			value:   "1,abc",
+			wantErr: true,
+		},
+		{
+			name:    "open range",
+			value:   "5-",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVlanList(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVlanList(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseVlanList(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVlanListContains(t *testing.T) {
+	ranges := []VlanRange{{1, 1}, {5, 10}}
+	tests := []struct {
+		id   int64
+		want bool
+	}{
+		{1, true},
+		{2, false},
+		{4, false},
+		{5, true},
+		{7, true},
+		{10, true},
+		{11, false},
+	}
+
+	for _, tt := range tests {
+		if got := VlanListContains(ranges, tt.id); got != tt.want {
+			t.Errorf("VlanListContains(%v, %d) = %v, want %v", ranges, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestVlanList(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:  "all",
+			value: types.StringValue("all"),
+		},
+		{
+			name:  "VLANs and ranges",
+			value: types.StringValue("1,3,5-10"),
+		},
+		{
+			name:    "reversed range",
+			value:   types.StringValue("10-5"),
+			wantErr: true,
+		},
+		{
+			name:    "out of range",
+			value:   types.StringValue("4095"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("allowed_vlans"),
+				ConfigValue: tt.value,
+			}
+			var resp validator.StringResponse
+			VlanList().ValidateString(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("VlanList() on %s: errors = %v, wantErr %v", tt.value, resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package meraki
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxSynchronousActionBatchActions is the maximum number of actions of a synchronous action batch.
+const MaxSynchronousActionBatchActions = 20
+
+type ActionBatchAction struct {
+	Resource  string `json:"resource"`
+	Operation string `json:"operation"`
+	Body      any    `json:"body,omitempty"`
+}
+
+type ActionBatchStatus struct {
+	Completed bool     `json:"completed"`
+	Failed    bool     `json:"failed"`
+	Errors    []string `json:"errors"`
+}
+
+type ActionBatch struct {
+	ID          string              `json:"id,omitempty"`
+	Confirmed   bool                `json:"confirmed"`
+	Synchronous bool                `json:"synchronous"`
+	Actions     []ActionBatchAction `json:"actions"`
+	Status      *ActionBatchStatus  `json:"status,omitempty"`
+}
+
+// Err returns the errors of a failed action batch.
+func (b *ActionBatch) Err() error {
+	if b.Status == nil || !b.Status.Failed {
+		return nil
+	}
+	return fmt.Errorf("action batch %s failed: %s", b.ID, strings.Join(b.Status.Errors, "; "))
+}
+
+func (c *client) CreateOrganizationActionBatch(orgID string, batch *ActionBatch) (*ActionBatch, error) {
+	endpoint := base_url + "/organizations/" + orgID + "/actionBatches"
+
+	var created ActionBatch
+	_, err := c.doRequest("POST", endpoint, batch, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
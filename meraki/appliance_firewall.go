@@ -0,0 +1,198 @@
+package meraki
+
+import "encoding/json"
+
+type ApplianceFirewallRule struct {
+	Comment       string `json:"comment"`
+	Policy        string `json:"policy"`
+	Protocol      string `json:"protocol"`
+	SrcPort       string `json:"srcPort,omitempty"`
+	SrcCidr       string `json:"srcCidr"`
+	DestPort      string `json:"destPort,omitempty"`
+	DestCidr      string `json:"destCidr"`
+	SyslogEnabled bool   `json:"syslogEnabled"`
+}
+
+type ApplianceFirewallRules struct {
+	Rules             []ApplianceFirewallRule `json:"rules"`
+	SyslogDefaultRule *bool                   `json:"syslogDefaultRule,omitempty"`
+}
+
+func (c *client) GetApplianceL3FirewallRules(networkID string) (*ApplianceFirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/l3FirewallRules"
+
+	var rules ApplianceFirewallRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateApplianceL3FirewallRules(networkID string, rules *ApplianceFirewallRules) (*ApplianceFirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/l3FirewallRules"
+
+	var updated ApplianceFirewallRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceInboundFirewallRules(networkID string) (*ApplianceFirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/inboundFirewallRules"
+
+	var rules ApplianceFirewallRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateApplianceInboundFirewallRules(networkID string, rules *ApplianceFirewallRules) (*ApplianceFirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/inboundFirewallRules"
+
+	var updated ApplianceFirewallRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceCellularFirewallRules(networkID string) (*ApplianceFirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/cellularFirewallRules"
+
+	var rules ApplianceFirewallRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateApplianceCellularFirewallRules(networkID string, rules *ApplianceFirewallRules) (*ApplianceFirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/cellularFirewallRules"
+
+	var updated ApplianceFirewallRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// ApplianceL7FirewallRule is a layer 7 firewall rule. The API encodes the rule value depending on
+// the rule type: an {id, name} object for applications and application categories, a list of
+// country codes for blockedCountries and allowedCountries, and a plain string otherwise.
+type ApplianceL7FirewallRule struct {
+	Policy string
+	Type   string
+	// Value is the host, port or IP range, or the ID of the application or application category.
+	Value string
+	// Countries are the ISO 3166-1 alpha-2 country codes of blockedCountries and allowedCountries rules.
+	Countries []string
+}
+
+type applianceL7FirewallRuleJSON struct {
+	Policy string          `json:"policy"`
+	Type   string          `json:"type"`
+	Value  json.RawMessage `json:"value"`
+}
+
+type ApplianceL7Application struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (r ApplianceL7FirewallRule) MarshalJSON() ([]byte, error) {
+	var value any
+	switch r.Type {
+	case "application", "applicationCategory":
+		value = map[string]string{"id": r.Value}
+	case "blockedCountries", "allowedCountries":
+		value = r.Countries
+	default:
+		value = r.Value
+	}
+
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(applianceL7FirewallRuleJSON{
+		Policy: r.Policy,
+		Type:   r.Type,
+		Value:  rawValue,
+	})
+}
+
+func (r *ApplianceL7FirewallRule) UnmarshalJSON(data []byte) error {
+	var raw applianceL7FirewallRuleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Policy = raw.Policy
+	r.Type = raw.Type
+	switch raw.Type {
+	case "application", "applicationCategory":
+		var app ApplianceL7Application
+		if err := json.Unmarshal(raw.Value, &app); err != nil {
+			return err
+		}
+		r.Value = app.ID
+	case "blockedCountries", "allowedCountries":
+		return json.Unmarshal(raw.Value, &r.Countries)
+	default:
+		return json.Unmarshal(raw.Value, &r.Value)
+	}
+	return nil
+}
+
+type ApplianceL7FirewallRules struct {
+	Rules []ApplianceL7FirewallRule `json:"rules"`
+}
+
+type ApplianceL7ApplicationCategory struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	Applications []ApplianceL7Application `json:"applications"`
+}
+
+func (c *client) GetApplianceL7FirewallRules(networkID string) (*ApplianceL7FirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/l7FirewallRules"
+
+	var rules ApplianceL7FirewallRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateApplianceL7FirewallRules(networkID string, rules *ApplianceL7FirewallRules) (*ApplianceL7FirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/l7FirewallRules"
+
+	var updated ApplianceL7FirewallRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceL7ApplicationCategories(networkID string) ([]ApplianceL7ApplicationCategory, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/l7FirewallRules/applicationCategories"
+
+	var result struct {
+		ApplicationCategories []ApplianceL7ApplicationCategory `json:"applicationCategories"`
+	}
+	_, err := c.doRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.ApplicationCategories, nil
+}
@@ -0,0 +1,118 @@
+package meraki
+
+type AppliancePortForwardingRule struct {
+	Name       string   `json:"name"`
+	LanIP      string   `json:"lanIp"`
+	PublicPort string   `json:"publicPort"`
+	LocalPort  string   `json:"localPort"`
+	AllowedIPs []string `json:"allowedIps"`
+	Protocol   string   `json:"protocol"`
+	Uplink     string   `json:"uplink"`
+}
+
+type AppliancePortForwardingRules struct {
+	Rules []AppliancePortForwardingRule `json:"rules"`
+}
+
+type ApplianceOneToOneNatAllowedInbound struct {
+	Protocol         string   `json:"protocol"`
+	DestinationPorts []string `json:"destinationPorts,omitempty"`
+	AllowedIPs       []string `json:"allowedIps"`
+}
+
+type ApplianceOneToOneNatRule struct {
+	Name           string                               `json:"name"`
+	PublicIP       string                               `json:"publicIp"`
+	LanIP          string                               `json:"lanIp"`
+	Uplink         string                               `json:"uplink"`
+	AllowedInbound []ApplianceOneToOneNatAllowedInbound `json:"allowedInbound"`
+}
+
+type ApplianceOneToOneNatRules struct {
+	Rules []ApplianceOneToOneNatRule `json:"rules"`
+}
+
+type ApplianceOneToManyNatPortRule struct {
+	Name       string   `json:"name"`
+	Protocol   string   `json:"protocol"`
+	PublicPort string   `json:"publicPort"`
+	LocalIP    string   `json:"localIp"`
+	LocalPort  string   `json:"localPort"`
+	AllowedIPs []string `json:"allowedIps"`
+}
+
+type ApplianceOneToManyNatRule struct {
+	PublicIP  string                          `json:"publicIp"`
+	Uplink    string                          `json:"uplink"`
+	PortRules []ApplianceOneToManyNatPortRule `json:"portRules"`
+}
+
+type ApplianceOneToManyNatRules struct {
+	Rules []ApplianceOneToManyNatRule `json:"rules"`
+}
+
+func (c *client) GetAppliancePortForwardingRules(networkID string) (*AppliancePortForwardingRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/portForwardingRules"
+
+	var rules AppliancePortForwardingRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateAppliancePortForwardingRules(networkID string, rules *AppliancePortForwardingRules) (*AppliancePortForwardingRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/portForwardingRules"
+
+	var updated AppliancePortForwardingRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceOneToOneNatRules(networkID string) (*ApplianceOneToOneNatRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/oneToOneNatRules"
+
+	var rules ApplianceOneToOneNatRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateApplianceOneToOneNatRules(networkID string, rules *ApplianceOneToOneNatRules) (*ApplianceOneToOneNatRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/oneToOneNatRules"
+
+	var updated ApplianceOneToOneNatRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceOneToManyNatRules(networkID string) (*ApplianceOneToManyNatRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/oneToManyNatRules"
+
+	var rules ApplianceOneToManyNatRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateApplianceOneToManyNatRules(networkID string, rules *ApplianceOneToManyNatRules) (*ApplianceOneToManyNatRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/firewall/oneToManyNatRules"
+
+	var updated ApplianceOneToManyNatRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
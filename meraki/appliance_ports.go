@@ -0,0 +1,33 @@
+package meraki
+
+type AppliancePort struct {
+	Number              int64  `json:"number,omitempty"`
+	Enabled             bool   `json:"enabled"`
+	Type                string `json:"type"`
+	DropUntaggedTraffic bool   `json:"dropUntaggedTraffic"`
+	Vlan                int64  `json:"vlan,omitempty"`
+	AllowedVlans        string `json:"allowedVlans,omitempty"`
+	AccessPolicy        string `json:"accessPolicy,omitempty"`
+}
+
+func (c *client) GetAppliancePort(networkID string, portID string) (*AppliancePort, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/ports/" + portID
+
+	var port AppliancePort
+	_, err := c.doRequest("GET", endpoint, nil, &port)
+	if err != nil {
+		return nil, err
+	}
+	return &port, nil
+}
+
+func (c *client) UpdateAppliancePort(networkID string, portID string, port *AppliancePort) (*AppliancePort, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/ports/" + portID
+
+	var updated AppliancePort
+	_, err := c.doRequest("PUT", endpoint, port, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,129 @@
+package meraki
+
+type ApplianceContentFilteringCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ApplianceContentFiltering struct {
+	AllowedURLPatterns   []string                            `json:"allowedUrlPatterns"`
+	BlockedURLPatterns   []string                            `json:"blockedUrlPatterns"`
+	BlockedURLCategories []ApplianceContentFilteringCategory `json:"blockedUrlCategories"`
+	URLCategoryListSize  string                              `json:"urlCategoryListSize"`
+}
+
+// ApplianceContentFilteringUpdateRequest references the blocked categories by ID only, while
+// they are returned with their names.
+type ApplianceContentFilteringUpdateRequest struct {
+	AllowedURLPatterns   []string `json:"allowedUrlPatterns"`
+	BlockedURLPatterns   []string `json:"blockedUrlPatterns"`
+	BlockedURLCategories []string `json:"blockedUrlCategories"`
+	URLCategoryListSize  string   `json:"urlCategoryListSize,omitempty"`
+}
+
+type ApplianceIntrusionProtectedNetworks struct {
+	UseDefault   bool     `json:"useDefault"`
+	IncludedCidr []string `json:"includedCidr,omitempty"`
+	ExcludedCidr []string `json:"excludedCidr,omitempty"`
+}
+
+type ApplianceIntrusion struct {
+	Mode              string                               `json:"mode"`
+	IdsRulesets       string                               `json:"idsRulesets,omitempty"`
+	ProtectedNetworks *ApplianceIntrusionProtectedNetworks `json:"protectedNetworks,omitempty"`
+}
+
+type ApplianceMalwareAllowedURL struct {
+	URL     string `json:"url"`
+	Comment string `json:"comment"`
+}
+
+type ApplianceMalwareAllowedFile struct {
+	Sha256  string `json:"sha256"`
+	Comment string `json:"comment"`
+}
+
+type ApplianceMalware struct {
+	Mode         string                        `json:"mode"`
+	AllowedURLs  []ApplianceMalwareAllowedURL  `json:"allowedUrls"`
+	AllowedFiles []ApplianceMalwareAllowedFile `json:"allowedFiles"`
+}
+
+func (c *client) GetApplianceContentFiltering(networkID string) (*ApplianceContentFiltering, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/contentFiltering"
+
+	var filtering ApplianceContentFiltering
+	_, err := c.doRequest("GET", endpoint, nil, &filtering)
+	if err != nil {
+		return nil, err
+	}
+	return &filtering, nil
+}
+
+func (c *client) UpdateApplianceContentFiltering(networkID string, filtering *ApplianceContentFilteringUpdateRequest) (*ApplianceContentFiltering, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/contentFiltering"
+
+	var updated ApplianceContentFiltering
+	_, err := c.doRequest("PUT", endpoint, filtering, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceContentFilteringCategories(networkID string) ([]ApplianceContentFilteringCategory, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/contentFiltering/categories"
+
+	var result struct {
+		Categories []ApplianceContentFilteringCategory `json:"categories"`
+	}
+	_, err := c.doRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Categories, nil
+}
+
+func (c *client) GetApplianceIntrusion(networkID string) (*ApplianceIntrusion, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/security/intrusion"
+
+	var intrusion ApplianceIntrusion
+	_, err := c.doRequest("GET", endpoint, nil, &intrusion)
+	if err != nil {
+		return nil, err
+	}
+	return &intrusion, nil
+}
+
+func (c *client) UpdateApplianceIntrusion(networkID string, intrusion *ApplianceIntrusion) (*ApplianceIntrusion, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/security/intrusion"
+
+	var updated ApplianceIntrusion
+	_, err := c.doRequest("PUT", endpoint, intrusion, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceMalware(networkID string) (*ApplianceMalware, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/security/malware"
+
+	var malware ApplianceMalware
+	_, err := c.doRequest("GET", endpoint, nil, &malware)
+	if err != nil {
+		return nil, err
+	}
+	return &malware, nil
+}
+
+func (c *client) UpdateApplianceMalware(networkID string, malware *ApplianceMalware) (*ApplianceMalware, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/security/malware"
+
+	var updated ApplianceMalware
+	_, err := c.doRequest("PUT", endpoint, malware, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
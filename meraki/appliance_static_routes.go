@@ -0,0 +1,59 @@
+package meraki
+
+type ApplianceStaticRoute struct {
+	ID                 string                       `json:"id,omitempty"`
+	NetworkID          string                       `json:"networkId,omitempty"`
+	Name               string                       `json:"name"`
+	Subnet             string                       `json:"subnet"`
+	GatewayIP          string                       `json:"gatewayIp,omitempty"`
+	GatewayVlanID      *int64                       `json:"gatewayVlanId,omitempty"`
+	Enabled            *bool                        `json:"enabled,omitempty"`
+	FixedIPAssignments map[string]FixedIPAssignment `json:"fixedIpAssignments"`
+	ReservedIPRanges   []ReservedIPRange            `json:"reservedIpRanges"`
+}
+
+type ApplianceStaticRouteCreateRequest struct {
+	Name          string `json:"name"`
+	Subnet        string `json:"subnet"`
+	GatewayIP     string `json:"gatewayIp,omitempty"`
+	GatewayVlanID *int64 `json:"gatewayVlanId,omitempty"`
+}
+
+func (c *client) GetApplianceStaticRoute(networkID string, routeID string) (*ApplianceStaticRoute, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/staticRoutes/" + routeID
+
+	var route ApplianceStaticRoute
+	_, err := c.doRequest("GET", endpoint, nil, &route)
+	if err != nil {
+		return nil, err
+	}
+	return &route, nil
+}
+
+func (c *client) CreateApplianceStaticRoute(networkID string, route *ApplianceStaticRouteCreateRequest) (*ApplianceStaticRoute, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/staticRoutes"
+
+	var created ApplianceStaticRoute
+	_, err := c.doRequest("POST", endpoint, route, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateApplianceStaticRoute(networkID string, routeID string, route *ApplianceStaticRoute) (*ApplianceStaticRoute, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/staticRoutes/" + routeID
+
+	var updated ApplianceStaticRoute
+	_, err := c.doRequest("PUT", endpoint, route, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteApplianceStaticRoute(networkID string, routeID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/staticRoutes/" + routeID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
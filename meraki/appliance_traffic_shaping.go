@@ -0,0 +1,198 @@
+package meraki
+
+import "encoding/json"
+
+type ApplianceBandwidthLimit struct {
+	// LimitUp and LimitDown are in Kbps, nil means unlimited.
+	LimitUp   *int64 `json:"limitUp"`
+	LimitDown *int64 `json:"limitDown"`
+}
+
+type ApplianceUplinkBandwidth struct {
+	BandwidthLimits struct {
+		Wan1     *ApplianceBandwidthLimit `json:"wan1,omitempty"`
+		Wan2     *ApplianceBandwidthLimit `json:"wan2,omitempty"`
+		Cellular *ApplianceBandwidthLimit `json:"cellular,omitempty"`
+	} `json:"bandwidthLimits"`
+}
+
+type ApplianceTrafficShapingDefinition struct {
+	Type string
+	// Value is the host, port, IP range or local network, or the ID of the application or application category.
+	Value string
+}
+
+type applianceTrafficShapingDefinitionJSON struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (d ApplianceTrafficShapingDefinition) MarshalJSON() ([]byte, error) {
+	var value any = d.Value
+	if d.Type == "application" || d.Type == "applicationCategory" {
+		value = map[string]string{"id": d.Value}
+	}
+
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(applianceTrafficShapingDefinitionJSON{
+		Type:  d.Type,
+		Value: rawValue,
+	})
+}
+
+func (d *ApplianceTrafficShapingDefinition) UnmarshalJSON(data []byte) error {
+	var raw applianceTrafficShapingDefinitionJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	d.Type = raw.Type
+	if raw.Type == "application" || raw.Type == "applicationCategory" {
+		var app ApplianceL7Application
+		if err := json.Unmarshal(raw.Value, &app); err != nil {
+			return err
+		}
+		d.Value = app.ID
+		return nil
+	}
+	return json.Unmarshal(raw.Value, &d.Value)
+}
+
+type ApplianceTrafficShapingPerClientBandwidthLimits struct {
+	Settings        string                   `json:"settings"`
+	BandwidthLimits *ApplianceBandwidthLimit `json:"bandwidthLimits,omitempty"`
+}
+
+type ApplianceTrafficShapingRule struct {
+	Definitions              []ApplianceTrafficShapingDefinition              `json:"definitions"`
+	PerClientBandwidthLimits *ApplianceTrafficShapingPerClientBandwidthLimits `json:"perClientBandwidthLimits,omitempty"`
+	DscpTagValue             *int64                                           `json:"dscpTagValue"`
+	Priority                 string                                           `json:"priority,omitempty"`
+}
+
+type ApplianceTrafficShapingRules struct {
+	DefaultRulesEnabled bool                          `json:"defaultRulesEnabled"`
+	Rules               []ApplianceTrafficShapingRule `json:"rules"`
+}
+
+type ApplianceTrafficFilterEndpoint struct {
+	Port    string `json:"port,omitempty"`
+	Cidr    string `json:"cidr,omitempty"`
+	Network string `json:"network,omitempty"`
+	Vlan    *int64 `json:"vlan,omitempty"`
+	Host    *int64 `json:"host,omitempty"`
+	Fqdn    string `json:"fqdn,omitempty"`
+}
+
+type ApplianceTrafficFilterValue struct {
+	// ID is set for application and applicationCategory filters, the other fields for custom filters.
+	ID          string                          `json:"id,omitempty"`
+	Protocol    string                          `json:"protocol,omitempty"`
+	Source      *ApplianceTrafficFilterEndpoint `json:"source,omitempty"`
+	Destination *ApplianceTrafficFilterEndpoint `json:"destination,omitempty"`
+}
+
+type ApplianceTrafficFilter struct {
+	Type  string                      `json:"type"`
+	Value ApplianceTrafficFilterValue `json:"value"`
+}
+
+type ApplianceWanTrafficUplinkPreference struct {
+	TrafficFilters  []ApplianceTrafficFilter `json:"trafficFilters"`
+	PreferredUplink string                   `json:"preferredUplink"`
+}
+
+type ApplianceVpnTrafficPerformanceClass struct {
+	Type                        string `json:"type"`
+	BuiltinPerformanceClassName string `json:"builtinPerformanceClassName,omitempty"`
+	CustomPerformanceClassID    string `json:"customPerformanceClassId,omitempty"`
+}
+
+type ApplianceVpnTrafficUplinkPreference struct {
+	TrafficFilters    []ApplianceTrafficFilter             `json:"trafficFilters"`
+	PreferredUplink   string                               `json:"preferredUplink"`
+	FailOverCriterion string                               `json:"failOverCriterion,omitempty"`
+	PerformanceClass  *ApplianceVpnTrafficPerformanceClass `json:"performanceClass,omitempty"`
+}
+
+type ApplianceUplinkSelection struct {
+	ActiveActiveAutoVpnEnabled bool   `json:"activeActiveAutoVpnEnabled"`
+	DefaultUplink              string `json:"defaultUplink"`
+	LoadBalancingEnabled       bool   `json:"loadBalancingEnabled"`
+	FailoverAndFailback        struct {
+		Immediate struct {
+			Enabled bool `json:"enabled"`
+		} `json:"immediate"`
+	} `json:"failoverAndFailback"`
+	WanTrafficUplinkPreferences []ApplianceWanTrafficUplinkPreference `json:"wanTrafficUplinkPreferences"`
+	VpnTrafficUplinkPreferences []ApplianceVpnTrafficUplinkPreference `json:"vpnTrafficUplinkPreferences"`
+}
+
+func (c *client) GetApplianceUplinkBandwidth(networkID string) (*ApplianceUplinkBandwidth, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/trafficShaping/uplinkBandwidth"
+
+	var bandwidth ApplianceUplinkBandwidth
+	_, err := c.doRequest("GET", endpoint, nil, &bandwidth)
+	if err != nil {
+		return nil, err
+	}
+	return &bandwidth, nil
+}
+
+func (c *client) UpdateApplianceUplinkBandwidth(networkID string, bandwidth *ApplianceUplinkBandwidth) (*ApplianceUplinkBandwidth, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/trafficShaping/uplinkBandwidth"
+
+	var updated ApplianceUplinkBandwidth
+	_, err := c.doRequest("PUT", endpoint, bandwidth, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceTrafficShapingRules(networkID string) (*ApplianceTrafficShapingRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/trafficShaping/rules"
+
+	var rules ApplianceTrafficShapingRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateApplianceTrafficShapingRules(networkID string, rules *ApplianceTrafficShapingRules) (*ApplianceTrafficShapingRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/trafficShaping/rules"
+
+	var updated ApplianceTrafficShapingRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetApplianceUplinkSelection(networkID string) (*ApplianceUplinkSelection, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/trafficShaping/uplinkSelection"
+
+	var selection ApplianceUplinkSelection
+	_, err := c.doRequest("GET", endpoint, nil, &selection)
+	if err != nil {
+		return nil, err
+	}
+	return &selection, nil
+}
+
+func (c *client) UpdateApplianceUplinkSelection(networkID string, selection *ApplianceUplinkSelection) (*ApplianceUplinkSelection, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/trafficShaping/uplinkSelection"
+
+	var updated ApplianceUplinkSelection
+	_, err := c.doRequest("PUT", endpoint, selection, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,140 @@
+package meraki
+
+type ReservedIPRange struct {
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	Comment string `json:"comment"`
+}
+
+type FixedIPAssignment struct {
+	IP   string `json:"ip"`
+	Name string `json:"name,omitempty"`
+}
+
+type ApplianceVLANDhcpOption struct {
+	Code  string `json:"code"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type ApplianceVLANIPv6Origin struct {
+	Type       string   `json:"type"`
+	Interfaces []string `json:"interfaces,omitempty"`
+}
+
+type ApplianceVLANIPv6PrefixAssignment struct {
+	Autonomous         bool                    `json:"autonomous"`
+	StaticPrefix       string                  `json:"staticPrefix,omitempty"`
+	StaticApplianceIP6 string                  `json:"staticApplianceIp6,omitempty"`
+	Origin             ApplianceVLANIPv6Origin `json:"origin"`
+}
+
+type ApplianceVLANIPv6 struct {
+	Enabled           bool                                `json:"enabled"`
+	PrefixAssignments []ApplianceVLANIPv6PrefixAssignment `json:"prefixAssignments,omitempty"`
+}
+
+type ApplianceVLAN struct {
+	ID                     FlexibleID                   `json:"id,omitempty"`
+	NetworkID              string                       `json:"networkId,omitempty"`
+	Name                   string                       `json:"name"`
+	Subnet                 string                       `json:"subnet"`
+	ApplianceIP            string                       `json:"applianceIp"`
+	GroupPolicyID          *string                      `json:"groupPolicyId,omitempty"`
+	DhcpHandling           string                       `json:"dhcpHandling,omitempty"`
+	DhcpRelayServerIPs     *[]string                    `json:"dhcpRelayServerIps,omitempty"`
+	DhcpLeaseTime          string                       `json:"dhcpLeaseTime,omitempty"`
+	DhcpBootOptionsEnabled *bool                        `json:"dhcpBootOptionsEnabled,omitempty"`
+	DhcpBootNextServer     *string                      `json:"dhcpBootNextServer,omitempty"`
+	DhcpBootFilename       *string                      `json:"dhcpBootFilename,omitempty"`
+	DhcpOptions            []ApplianceVLANDhcpOption    `json:"dhcpOptions"`
+	FixedIPAssignments     map[string]FixedIPAssignment `json:"fixedIpAssignments"`
+	ReservedIPRanges       []ReservedIPRange            `json:"reservedIpRanges"`
+	DNSNameservers         string                       `json:"dnsNameservers,omitempty"`
+	IPv6                   *ApplianceVLANIPv6           `json:"ipv6,omitempty"`
+}
+
+type ApplianceVLANCreateRequest struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	Subnet        string             `json:"subnet"`
+	ApplianceIP   string             `json:"applianceIp"`
+	GroupPolicyID string             `json:"groupPolicyId,omitempty"`
+	IPv6          *ApplianceVLANIPv6 `json:"ipv6,omitempty"`
+}
+
+type ApplianceVLANsSettings struct {
+	VlansEnabled bool `json:"vlansEnabled"`
+}
+
+func (c *client) GetApplianceVLANs(networkID string) ([]ApplianceVLAN, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vlans"
+
+	var vlans []ApplianceVLAN
+	_, err := c.doRequest("GET", endpoint, nil, &vlans)
+	if err != nil {
+		return nil, err
+	}
+	return vlans, nil
+}
+
+func (c *client) GetApplianceVLAN(networkID string, vlanID string) (*ApplianceVLAN, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vlans/" + vlanID
+
+	var vlan ApplianceVLAN
+	_, err := c.doRequest("GET", endpoint, nil, &vlan)
+	if err != nil {
+		return nil, err
+	}
+	return &vlan, nil
+}
+
+func (c *client) CreateApplianceVLAN(networkID string, vlan *ApplianceVLANCreateRequest) (*ApplianceVLAN, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vlans"
+
+	var created ApplianceVLAN
+	_, err := c.doRequest("POST", endpoint, vlan, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateApplianceVLAN(networkID string, vlanID string, vlan *ApplianceVLAN) (*ApplianceVLAN, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vlans/" + vlanID
+
+	var updated ApplianceVLAN
+	_, err := c.doRequest("PUT", endpoint, vlan, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteApplianceVLAN(networkID string, vlanID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vlans/" + vlanID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
+
+func (c *client) GetApplianceVLANsSettings(networkID string) (*ApplianceVLANsSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vlans/settings"
+
+	var settings ApplianceVLANsSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateApplianceVLANsSettings(networkID string, settings *ApplianceVLANsSettings) (*ApplianceVLANsSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vlans/settings"
+
+	var updated ApplianceVLANsSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,111 @@
+package meraki
+
+import (
+	"net/url"
+	"strconv"
+)
+
+type ApplianceSiteToSiteVPNHub struct {
+	HubID           string `json:"hubId"`
+	UseDefaultRoute bool   `json:"useDefaultRoute"`
+}
+
+type ApplianceSiteToSiteVPNSubnet struct {
+	LocalSubnet string `json:"localSubnet"`
+	UseVPN      bool   `json:"useVpn"`
+}
+
+type ApplianceSiteToSiteVPN struct {
+	Mode    string                         `json:"mode"`
+	Hubs    []ApplianceSiteToSiteVPNHub    `json:"hubs,omitempty"`
+	Subnets []ApplianceSiteToSiteVPNSubnet `json:"subnets,omitempty"`
+}
+
+type ApplianceVPNStatus struct {
+	NetworkID   string `json:"networkId"`
+	NetworkName string `json:"networkName"`
+	VpnMode     string `json:"vpnMode"`
+}
+
+func (c *client) GetApplianceSiteToSiteVPN(networkID string) (*ApplianceSiteToSiteVPN, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vpn/siteToSiteVpn"
+
+	var vpn ApplianceSiteToSiteVPN
+	_, err := c.doRequest("GET", endpoint, nil, &vpn)
+	if err != nil {
+		return nil, err
+	}
+	return &vpn, nil
+}
+
+func (c *client) UpdateApplianceSiteToSiteVPN(networkID string, vpn *ApplianceSiteToSiteVPN) (*ApplianceSiteToSiteVPN, error) {
+	endpoint := base_url + "/networks/" + networkID + "/appliance/vpn/siteToSiteVpn"
+
+	var updated ApplianceSiteToSiteVPN
+	_, err := c.doRequest("PUT", endpoint, vpn, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+type ApplianceThirdPartyVPNIPsecPolicies struct {
+	IkeCipherAlgo         []string `json:"ikeCipherAlgo"`
+	IkeAuthAlgo           []string `json:"ikeAuthAlgo"`
+	IkePrfAlgo            []string `json:"ikePrfAlgo,omitempty"`
+	IkeDiffieHellmanGroup []string `json:"ikeDiffieHellmanGroup"`
+	IkeLifetime           int64    `json:"ikeLifetime"`
+	ChildCipherAlgo       []string `json:"childCipherAlgo"`
+	ChildAuthAlgo         []string `json:"childAuthAlgo"`
+	ChildPfsGroup         []string `json:"childPfsGroup"`
+	ChildLifetime         int64    `json:"childLifetime"`
+}
+
+type ApplianceThirdPartyVPNPeer struct {
+	Name                string                               `json:"name"`
+	PublicIP            string                               `json:"publicIp,omitempty"`
+	PublicHostname      string                               `json:"publicHostname,omitempty"`
+	PrivateSubnets      []string                             `json:"privateSubnets"`
+	LocalID             string                               `json:"localId,omitempty"`
+	RemoteID            string                               `json:"remoteId,omitempty"`
+	Secret              string                               `json:"secret"`
+	IkeVersion          string                               `json:"ikeVersion,omitempty"`
+	NetworkTags         []string                             `json:"networkTags,omitempty"`
+	IpsecPoliciesPreset string                               `json:"ipsecPoliciesPreset,omitempty"`
+	IpsecPolicies       *ApplianceThirdPartyVPNIPsecPolicies `json:"ipsecPolicies,omitempty"`
+}
+
+type ApplianceThirdPartyVPNPeers struct {
+	Peers []ApplianceThirdPartyVPNPeer `json:"peers"`
+}
+
+func (c *client) GetApplianceThirdPartyVPNPeers(orgID string) (*ApplianceThirdPartyVPNPeers, error) {
+	endpoint := base_url + "/organizations/" + orgID + "/appliance/vpn/thirdPartyVPNPeers"
+
+	var peers ApplianceThirdPartyVPNPeers
+	_, err := c.doRequest("GET", endpoint, nil, &peers)
+	if err != nil {
+		return nil, err
+	}
+	return &peers, nil
+}
+
+func (c *client) UpdateApplianceThirdPartyVPNPeers(orgID string, peers *ApplianceThirdPartyVPNPeers) (*ApplianceThirdPartyVPNPeers, error) {
+	endpoint := base_url + "/organizations/" + orgID + "/appliance/vpn/thirdPartyVPNPeers"
+
+	var updated ApplianceThirdPartyVPNPeers
+	_, err := c.doRequest("PUT", endpoint, peers, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetOrganizationApplianceVPNStatuses(orgID string, networkIDs []string) ([]ApplianceVPNStatus, error) {
+	endpoint := base_url + "/organizations/" + orgID + "/appliance/vpn/statuses"
+
+	query := url.Values{}
+	query.Set("perPage", strconv.Itoa(300))
+	addListQuery(query, "networkIds", networkIDs)
+	return getPaginated[ApplianceVPNStatus](c, endpoint, query)
+}
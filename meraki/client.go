@@ -63,17 +63,204 @@ type NetworkUpdateRequest struct {
 	Tags     []string `json:"tags,omitempty"`
 }
 
+// FlexibleID is an identifier the API returns either as a JSON string or a JSON number
+// depending on the endpoint, e.g. appliance VLAN IDs.
+type FlexibleID string
+
+func (id *FlexibleID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = FlexibleID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*id = FlexibleID(n.String())
+	return nil
+}
+
 type Client interface {
 	// Organizations
 	GetOrganizations() ([]Organization, error)
 	GetOrganization(orgID string) (*Organization, error)
 
+	// Organization inventory
+	GetOrganizationInventoryDevices(orgID string, filter *InventoryDevicesFilter) ([]InventoryDevice, error)
+	ClaimIntoOrganizationInventory(orgID string, claim *InventoryClaimRequest) error
+	ReleaseFromOrganizationInventory(orgID string, serials []string) error
+
+	// Organization action batches
+	CreateOrganizationActionBatch(orgID string, batch *ActionBatch) (*ActionBatch, error)
+
 	// Networks
 	CreateNetwork(orgID string, network *NetworkCreateRequest) (*Network, error)
 	GetNetwork(id string) (*Network, error)
 	GetNetworkInOrg(OrgID string, id string) (*Network, error)
 	UpdateNetwork(id string, network *NetworkUpdateRequest) (*Network, error)
 	DeleteNetwork(id string) error
+
+	// Devices
+	GetOrganizationDevices(orgID string, filter *DevicesFilter) ([]Device, error)
+	GetOrganizationDeviceStatuses(orgID string, filter *DeviceStatusesFilter) ([]DeviceStatus, error)
+
+	// Appliance VLANs
+	GetApplianceVLANs(networkID string) ([]ApplianceVLAN, error)
+	GetApplianceVLAN(networkID string, vlanID string) (*ApplianceVLAN, error)
+	CreateApplianceVLAN(networkID string, vlan *ApplianceVLANCreateRequest) (*ApplianceVLAN, error)
+	UpdateApplianceVLAN(networkID string, vlanID string, vlan *ApplianceVLAN) (*ApplianceVLAN, error)
+	DeleteApplianceVLAN(networkID string, vlanID string) error
+	GetApplianceVLANsSettings(networkID string) (*ApplianceVLANsSettings, error)
+	UpdateApplianceVLANsSettings(networkID string, settings *ApplianceVLANsSettings) (*ApplianceVLANsSettings, error)
+
+	// Appliance ports
+	GetAppliancePort(networkID string, portID string) (*AppliancePort, error)
+	UpdateAppliancePort(networkID string, portID string, port *AppliancePort) (*AppliancePort, error)
+
+	// Appliance static routes
+	GetApplianceStaticRoute(networkID string, routeID string) (*ApplianceStaticRoute, error)
+	CreateApplianceStaticRoute(networkID string, route *ApplianceStaticRouteCreateRequest) (*ApplianceStaticRoute, error)
+	UpdateApplianceStaticRoute(networkID string, routeID string, route *ApplianceStaticRoute) (*ApplianceStaticRoute, error)
+	DeleteApplianceStaticRoute(networkID string, routeID string) error
+
+	// Appliance firewall
+	GetApplianceL3FirewallRules(networkID string) (*ApplianceFirewallRules, error)
+	UpdateApplianceL3FirewallRules(networkID string, rules *ApplianceFirewallRules) (*ApplianceFirewallRules, error)
+	GetApplianceInboundFirewallRules(networkID string) (*ApplianceFirewallRules, error)
+	UpdateApplianceInboundFirewallRules(networkID string, rules *ApplianceFirewallRules) (*ApplianceFirewallRules, error)
+	GetApplianceCellularFirewallRules(networkID string) (*ApplianceFirewallRules, error)
+	UpdateApplianceCellularFirewallRules(networkID string, rules *ApplianceFirewallRules) (*ApplianceFirewallRules, error)
+	GetApplianceL7FirewallRules(networkID string) (*ApplianceL7FirewallRules, error)
+	UpdateApplianceL7FirewallRules(networkID string, rules *ApplianceL7FirewallRules) (*ApplianceL7FirewallRules, error)
+	GetApplianceL7ApplicationCategories(networkID string) ([]ApplianceL7ApplicationCategory, error)
+
+	// Appliance NAT
+	GetAppliancePortForwardingRules(networkID string) (*AppliancePortForwardingRules, error)
+	UpdateAppliancePortForwardingRules(networkID string, rules *AppliancePortForwardingRules) (*AppliancePortForwardingRules, error)
+	GetApplianceOneToOneNatRules(networkID string) (*ApplianceOneToOneNatRules, error)
+	UpdateApplianceOneToOneNatRules(networkID string, rules *ApplianceOneToOneNatRules) (*ApplianceOneToOneNatRules, error)
+	GetApplianceOneToManyNatRules(networkID string) (*ApplianceOneToManyNatRules, error)
+	UpdateApplianceOneToManyNatRules(networkID string, rules *ApplianceOneToManyNatRules) (*ApplianceOneToManyNatRules, error)
+
+	// Appliance content filtering and security
+	GetApplianceContentFiltering(networkID string) (*ApplianceContentFiltering, error)
+	UpdateApplianceContentFiltering(networkID string, filtering *ApplianceContentFilteringUpdateRequest) (*ApplianceContentFiltering, error)
+	GetApplianceContentFilteringCategories(networkID string) ([]ApplianceContentFilteringCategory, error)
+	GetApplianceIntrusion(networkID string) (*ApplianceIntrusion, error)
+	UpdateApplianceIntrusion(networkID string, intrusion *ApplianceIntrusion) (*ApplianceIntrusion, error)
+	GetApplianceMalware(networkID string) (*ApplianceMalware, error)
+	UpdateApplianceMalware(networkID string, malware *ApplianceMalware) (*ApplianceMalware, error)
+
+	// Appliance traffic shaping
+	GetApplianceUplinkBandwidth(networkID string) (*ApplianceUplinkBandwidth, error)
+	UpdateApplianceUplinkBandwidth(networkID string, bandwidth *ApplianceUplinkBandwidth) (*ApplianceUplinkBandwidth, error)
+	GetApplianceTrafficShapingRules(networkID string) (*ApplianceTrafficShapingRules, error)
+	UpdateApplianceTrafficShapingRules(networkID string, rules *ApplianceTrafficShapingRules) (*ApplianceTrafficShapingRules, error)
+	GetApplianceUplinkSelection(networkID string) (*ApplianceUplinkSelection, error)
+	UpdateApplianceUplinkSelection(networkID string, selection *ApplianceUplinkSelection) (*ApplianceUplinkSelection, error)
+
+	// Appliance VPN
+	GetApplianceSiteToSiteVPN(networkID string) (*ApplianceSiteToSiteVPN, error)
+	UpdateApplianceSiteToSiteVPN(networkID string, vpn *ApplianceSiteToSiteVPN) (*ApplianceSiteToSiteVPN, error)
+	GetOrganizationApplianceVPNStatuses(orgID string, networkIDs []string) ([]ApplianceVPNStatus, error)
+	GetApplianceThirdPartyVPNPeers(orgID string) (*ApplianceThirdPartyVPNPeers, error)
+	UpdateApplianceThirdPartyVPNPeers(orgID string, peers *ApplianceThirdPartyVPNPeers) (*ApplianceThirdPartyVPNPeers, error)
+
+	// Wireless SSIDs
+	GetWirelessSSID(networkID string, number string) (*WirelessSSID, error)
+	UpdateWirelessSSID(networkID string, number string, ssid *WirelessSSID) (*WirelessSSID, error)
+	GetWirelessSSIDL3FirewallRules(networkID string, number string) (*WirelessSSIDL3FirewallRules, error)
+	UpdateWirelessSSIDL3FirewallRules(networkID string, number string, rules *WirelessSSIDL3FirewallRules) (*WirelessSSIDL3FirewallRules, error)
+	GetWirelessSSIDL7FirewallRules(networkID string, number string) (*WirelessSSIDL7FirewallRules, error)
+	UpdateWirelessSSIDL7FirewallRules(networkID string, number string, rules *WirelessSSIDL7FirewallRules) (*WirelessSSIDL7FirewallRules, error)
+	GetWirelessSSIDTrafficShapingRules(networkID string, number string) (*WirelessSSIDTrafficShapingRules, error)
+	UpdateWirelessSSIDTrafficShapingRules(networkID string, number string, rules *WirelessSSIDTrafficShapingRules) (*WirelessSSIDTrafficShapingRules, error)
+	GetWirelessSSIDSplashSettings(networkID string, number string) (*WirelessSSIDSplashSettings, error)
+	UpdateWirelessSSIDSplashSettings(networkID string, number string, settings *WirelessSSIDSplashSettings) (*WirelessSSIDSplashSettings, error)
+	UpdateWirelessSSIDSplashGuestSponsorDomains(networkID string, number string, domains []string) (*WirelessSSID, error)
+	GetWirelessClientSplashAuthorizationStatus(networkID string, clientID string) (*WirelessClientSplashAuthorizationStatus, error)
+	UpdateWirelessClientSplashAuthorizationStatus(networkID string, clientID string, status *WirelessClientSplashAuthorizationStatus) (*WirelessClientSplashAuthorizationStatus, error)
+
+	// Wireless RF profiles
+	GetWirelessRfProfile(networkID string, rfProfileID string) (*WirelessRfProfile, error)
+	CreateWirelessRfProfile(networkID string, profile *WirelessRfProfile) (*WirelessRfProfile, error)
+	UpdateWirelessRfProfile(networkID string, rfProfileID string, profile *WirelessRfProfile) (*WirelessRfProfile, error)
+	DeleteWirelessRfProfile(networkID string, rfProfileID string) error
+
+	// Wireless settings
+	GetWirelessSettings(networkID string) (*WirelessSettings, error)
+	UpdateWirelessSettings(networkID string, settings *WirelessSettings) (*WirelessSettings, error)
+	GetWirelessBluetoothSettings(networkID string) (*WirelessBluetoothSettings, error)
+	UpdateWirelessBluetoothSettings(networkID string, settings *WirelessBluetoothSettings) (*WirelessBluetoothSettings, error)
+	GetWirelessDeviceBluetoothSettings(serial string) (*WirelessDeviceBluetoothSettings, error)
+	UpdateWirelessDeviceBluetoothSettings(serial string, settings *WirelessDeviceBluetoothSettings) (*WirelessDeviceBluetoothSettings, error)
+
+	// Switch ports
+	GetSwitchPorts(serial string) ([]SwitchPort, error)
+	GetSwitchPort(serial string, portID string) (*SwitchPort, error)
+	UpdateSwitchPort(serial string, portID string, port *SwitchPort) (*SwitchPort, error)
+
+	// Switch ACL and QoS
+	GetSwitchACL(networkID string) (*SwitchACL, error)
+	UpdateSwitchACL(networkID string, acl *SwitchACL) (*SwitchACL, error)
+	GetSwitchQosRules(networkID string) ([]SwitchQosRule, error)
+	CreateSwitchQosRule(networkID string, rule *SwitchQosRule) (*SwitchQosRule, error)
+	UpdateSwitchQosRule(networkID string, ruleID string, rule *SwitchQosRule) (*SwitchQosRule, error)
+	DeleteSwitchQosRule(networkID string, ruleID string) error
+	UpdateSwitchQosRulesOrder(networkID string, order *SwitchQosRulesOrder) (*SwitchQosRulesOrder, error)
+	GetSwitchDscpToCosMappings(networkID string) (*SwitchDscpToCosMappings, error)
+	UpdateSwitchDscpToCosMappings(networkID string, mappings *SwitchDscpToCosMappings) (*SwitchDscpToCosMappings, error)
+
+	// Switch stacks
+	GetSwitchStacks(networkID string) ([]SwitchStack, error)
+	GetSwitchStack(networkID string, stackID string) (*SwitchStack, error)
+	CreateSwitchStack(networkID string, stack *SwitchStack) (*SwitchStack, error)
+	DeleteSwitchStack(networkID string, stackID string) error
+	AddSwitchStackMember(networkID string, stackID string, serial string) (*SwitchStack, error)
+	RemoveSwitchStackMember(networkID string, stackID string, serial string) (*SwitchStack, error)
+
+	// Switch routing
+	GetSwitchRoutingInterface(serial string, interfaceID string) (*SwitchRoutingInterface, error)
+	CreateSwitchRoutingInterface(serial string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error)
+	UpdateSwitchRoutingInterface(serial string, interfaceID string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error)
+	DeleteSwitchRoutingInterface(serial string, interfaceID string) error
+	GetSwitchStaticRoute(serial string, routeID string) (*SwitchStaticRoute, error)
+	CreateSwitchStaticRoute(serial string, route *SwitchStaticRoute) (*SwitchStaticRoute, error)
+	UpdateSwitchStaticRoute(serial string, routeID string, route *SwitchStaticRoute) (*SwitchStaticRoute, error)
+	DeleteSwitchStaticRoute(serial string, routeID string) error
+	GetSwitchOspfSettings(networkID string) (*SwitchOspfSettings, error)
+	UpdateSwitchOspfSettings(networkID string, settings *SwitchOspfSettings) (*SwitchOspfSettings, error)
+	GetSwitchStackRoutingInterface(networkID string, stackID string, interfaceID string) (*SwitchRoutingInterface, error)
+	CreateSwitchStackRoutingInterface(networkID string, stackID string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error)
+	UpdateSwitchStackRoutingInterface(networkID string, stackID string, interfaceID string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error)
+	DeleteSwitchStackRoutingInterface(networkID string, stackID string, interfaceID string) error
+	GetSwitchStackStaticRoute(networkID string, stackID string, routeID string) (*SwitchStaticRoute, error)
+	CreateSwitchStackStaticRoute(networkID string, stackID string, route *SwitchStaticRoute) (*SwitchStaticRoute, error)
+	UpdateSwitchStackStaticRoute(networkID string, stackID string, routeID string, route *SwitchStaticRoute) (*SwitchStaticRoute, error)
+	DeleteSwitchStackStaticRoute(networkID string, stackID string, routeID string) error
+
+	// Switch settings
+	GetSwitchStpSettings(networkID string) (*SwitchStpSettings, error)
+	UpdateSwitchStpSettings(networkID string, settings *SwitchStpSettings) (*SwitchStpSettings, error)
+	GetSwitchMtu(networkID string) (*SwitchMtu, error)
+	UpdateSwitchMtu(networkID string, mtu *SwitchMtu) (*SwitchMtu, error)
+	GetSwitchStormControl(networkID string) (*SwitchStormControl, error)
+	UpdateSwitchStormControl(networkID string, stormControl *SwitchStormControl) (*SwitchStormControl, error)
+	GetSwitchSettings(networkID string) (*SwitchSettings, error)
+	UpdateSwitchSettings(networkID string, settings *SwitchSettings) (*SwitchSettings, error)
+
+	// Switch access and DHCP server policies
+	GetSwitchAccessPolicy(networkID string, accessPolicyNumber string) (*SwitchAccessPolicy, error)
+	CreateSwitchAccessPolicy(networkID string, policy *SwitchAccessPolicy) (*SwitchAccessPolicy, error)
+	UpdateSwitchAccessPolicy(networkID string, accessPolicyNumber string, policy *SwitchAccessPolicy) (*SwitchAccessPolicy, error)
+	DeleteSwitchAccessPolicy(networkID string, accessPolicyNumber string) error
+	GetSwitchDhcpServerPolicy(networkID string) (*SwitchDhcpServerPolicy, error)
+	UpdateSwitchDhcpServerPolicy(networkID string, policy *SwitchDhcpServerPolicy) (*SwitchDhcpServerPolicy, error)
+	GetSwitchArpInspectionTrustedServers(networkID string) ([]SwitchArpInspectionTrustedServer, error)
+	CreateSwitchArpInspectionTrustedServer(networkID string, server *SwitchArpInspectionTrustedServer) (*SwitchArpInspectionTrustedServer, error)
+	UpdateSwitchArpInspectionTrustedServer(networkID string, trustedServerID string, server *SwitchArpInspectionTrustedServer) (*SwitchArpInspectionTrustedServer, error)
+	DeleteSwitchArpInspectionTrustedServer(networkID string, trustedServerID string) error
 }
 
 func NewClient(apiToken string) Client {
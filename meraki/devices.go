@@ -0,0 +1,84 @@
+package meraki
+
+import (
+	"net/url"
+	"strconv"
+)
+
+type Device struct {
+	Serial      string   `json:"serial"`
+	Name        string   `json:"name"`
+	Model       string   `json:"model"`
+	Mac         string   `json:"mac"`
+	LanIP       string   `json:"lanIp"`
+	Firmware    string   `json:"firmware"`
+	NetworkID   string   `json:"networkId"`
+	ProductType string   `json:"productType"`
+	Address     string   `json:"address"`
+	Notes       string   `json:"notes"`
+	Tags        []string `json:"tags"`
+}
+
+type DeviceStatus struct {
+	Serial         string   `json:"serial"`
+	Name           string   `json:"name"`
+	Model          string   `json:"model"`
+	Mac            string   `json:"mac"`
+	NetworkID      string   `json:"networkId"`
+	ProductType    string   `json:"productType"`
+	Status         string   `json:"status"`
+	LastReportedAt string   `json:"lastReportedAt"`
+	PublicIP       string   `json:"publicIp"`
+	LanIP          string   `json:"lanIp"`
+	Gateway        string   `json:"gateway"`
+	Tags           []string `json:"tags"`
+}
+
+type DevicesFilter struct {
+	NetworkIDs     []string
+	Models         []string
+	ProductTypes   []string
+	Serials        []string
+	Tags           []string
+	TagsFilterType string
+}
+
+type DeviceStatusesFilter struct {
+	DevicesFilter
+	Statuses []string
+}
+
+func (f *DevicesFilter) query() url.Values {
+	query := url.Values{}
+	query.Set("perPage", strconv.Itoa(1000))
+	if f == nil {
+		return query
+	}
+	if f.TagsFilterType != "" {
+		query.Set("tagsFilterType", f.TagsFilterType)
+	}
+	addListQuery(query, "networkIds", f.NetworkIDs)
+	addListQuery(query, "models", f.Models)
+	addListQuery(query, "productTypes", f.ProductTypes)
+	addListQuery(query, "serials", f.Serials)
+	addListQuery(query, "tags", f.Tags)
+	return query
+}
+
+func (c *client) GetOrganizationDevices(orgID string, filter *DevicesFilter) ([]Device, error) {
+	endpoint := base_url + "/organizations/" + orgID + "/devices"
+	return getPaginated[Device](c, endpoint, filter.query())
+}
+
+func (c *client) GetOrganizationDeviceStatuses(orgID string, filter *DeviceStatusesFilter) ([]DeviceStatus, error) {
+	endpoint := base_url + "/organizations/" + orgID + "/devices/statuses"
+
+	var query url.Values
+	if filter != nil {
+		query = filter.DevicesFilter.query()
+		addListQuery(query, "statuses", filter.Statuses)
+	} else {
+		query = (*DevicesFilter)(nil).query()
+	}
+	return getPaginated[DeviceStatus](c, endpoint, query)
+}
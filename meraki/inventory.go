@@ -0,0 +1,77 @@
+package meraki
+
+import (
+	"net/url"
+	"strconv"
+)
+
+type InventoryDevice struct {
+	Mac                   string   `json:"mac"`
+	Serial                string   `json:"serial"`
+	Name                  string   `json:"name"`
+	Model                 string   `json:"model"`
+	NetworkID             string   `json:"networkId"`
+	OrderNumber           string   `json:"orderNumber"`
+	ClaimedAt             string   `json:"claimedAt"`
+	LicenseExpirationDate string   `json:"licenseExpirationDate"`
+	Tags                  []string `json:"tags"`
+	ProductType           string   `json:"productType"`
+	CountryCode           string   `json:"countryCode"`
+}
+
+type InventoryDevicesFilter struct {
+	UsedState      string
+	Models         []string
+	ProductTypes   []string
+	Serials        []string
+	Tags           []string
+	TagsFilterType string
+}
+
+type InventoryLicense struct {
+	Key  string `json:"key"`
+	Mode string `json:"mode,omitempty"`
+}
+
+type InventoryClaimRequest struct {
+	Orders   []string           `json:"orders,omitempty"`
+	Serials  []string           `json:"serials,omitempty"`
+	Licenses []InventoryLicense `json:"licenses,omitempty"`
+}
+
+type InventoryReleaseRequest struct {
+	Serials []string `json:"serials"`
+}
+
+func (c *client) GetOrganizationInventoryDevices(orgID string, filter *InventoryDevicesFilter) ([]InventoryDevice, error) {
+	endpoint := base_url + "/organizations/" + orgID + "/inventory/devices"
+
+	query := url.Values{}
+	query.Set("perPage", strconv.Itoa(1000))
+	if filter != nil {
+		if filter.UsedState != "" {
+			query.Set("usedState", filter.UsedState)
+		}
+		if filter.TagsFilterType != "" {
+			query.Set("tagsFilterType", filter.TagsFilterType)
+		}
+		addListQuery(query, "models", filter.Models)
+		addListQuery(query, "productTypes", filter.ProductTypes)
+		addListQuery(query, "serials", filter.Serials)
+		addListQuery(query, "tags", filter.Tags)
+	}
+
+	return getPaginated[InventoryDevice](c, endpoint, query)
+}
+
+func (c *client) ClaimIntoOrganizationInventory(orgID string, claim *InventoryClaimRequest) error {
+	endpoint := base_url + "/organizations/" + orgID + "/inventory/claim"
+	_, err := c.doRequest("POST", endpoint, claim, nil)
+	return err
+}
+
+func (c *client) ReleaseFromOrganizationInventory(orgID string, serials []string) error {
+	endpoint := base_url + "/organizations/" + orgID + "/inventory/release"
+	_, err := c.doRequest("POST", endpoint, &InventoryReleaseRequest{Serials: serials}, nil)
+	return err
+}
@@ -0,0 +1,138 @@
+package meraki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APIError is returned when the Meraki API responds with a non-2xx status code.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s returned status %d: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err was caused by the API responding with 404 Not Found.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// doRequest sends a request with an optional JSON body and decodes the JSON response into out
+// when out is not nil. Any non-2xx response is returned as an *APIError.
+func (c *client) doRequest(method string, endpoint string, body any, out any) (*http.Response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		rb, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewBuffer(rb)
+	}
+
+	req, err := http.NewRequest(method, endpoint, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	tflog.Debug(context.Background(), "Calling Meraki API", map[string]any{
+		"method": method,
+		"url":    endpoint,
+	})
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	tflog.Debug(context.Background(), "Called Meraki API", map[string]any{
+		"method": method,
+		"url":    endpoint,
+		"status": resp.StatusCode,
+	})
+
+	results, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, &APIError{
+			Method:     method,
+			URL:        endpoint,
+			StatusCode: resp.StatusCode,
+			Body:       string(results),
+		}
+	}
+
+	if out != nil && len(results) > 0 {
+		if err := json.Unmarshal(results, out); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// getPaginated follows the Link headers returned by the API until every page of
+// results has been read, returning the concatenated items.
+func getPaginated[T any](c *client, endpoint string, query url.Values) ([]T, error) {
+	next := endpoint
+	if len(query) > 0 {
+		next += "?" + query.Encode()
+	}
+
+	items := make([]T, 0)
+	for next != "" {
+		var page []T
+		resp, err := c.doRequest("GET", next, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		next = nextPageURL(resp.Header.Get("Link"))
+	}
+	return items, nil
+}
+
+// nextPageURL extracts the rel=next URL from a RFC 5988 Link header,
+// returning an empty string when there are no more pages.
+func nextPageURL(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		sections := strings.Split(part, ";")
+		if len(sections) < 2 {
+			continue
+		}
+		for _, param := range sections[1:] {
+			rel := strings.ReplaceAll(strings.TrimSpace(param), `"`, "")
+			if rel == "rel=next" {
+				return strings.Trim(strings.TrimSpace(sections[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
+
+// addListQuery adds each value under the Meraki array query parameter
+// convention, e.g. "models[]=MX68&models[]=MX84".
+func addListQuery(query url.Values, key string, values []string) {
+	for _, v := range values {
+		query.Add(key+"[]", v)
+	}
+}
@@ -0,0 +1,63 @@
+package meraki
+
+import "testing"
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{
+			name: "empty header",
+			link: "",
+			want: "",
+		},
+		{
+			name: "next only",
+			link: `<https://api.meraki.com/api/v1/organizations/1/devices?startingAfter=Q2AB>; rel=next`,
+			want: "https://api.meraki.com/api/v1/organizations/1/devices?startingAfter=Q2AB",
+		},
+		{
+			name: "quoted rel",
+			link: `<https://api.meraki.com/api/v1/organizations/1/devices?startingAfter=Q2AB>; rel="next"`,
+			want: "https://api.meraki.com/api/v1/organizations/1/devices?startingAfter=Q2AB",
+		},
+		{
+			name: "next among other relations",
+			link: `<https://api.meraki.com/api/v1/organizations/1/devices?perPage=10>; rel=first, ` +
+				`<https://api.meraki.com/api/v1/organizations/1/devices?startingAfter=Q2AB>; rel=next, ` +
+				`<https://api.meraki.com/api/v1/organizations/1/devices?endingBefore=zzzz>; rel=last`,
+			want: "https://api.meraki.com/api/v1/organizations/1/devices?startingAfter=Q2AB",
+		},
+		{
+			name: "last page",
+			link: `<https://api.meraki.com/api/v1/organizations/1/devices?perPage=10>; rel=first, ` +
+				`<https://api.meraki.com/api/v1/organizations/1/devices?endingBefore=Q2AB>; rel=prev`,
+			want: "",
+		},
+		{
+			name: "rel with extra parameters",
+			link: `<https://api.meraki.com/api/v1/networks/N_1/clients?startingAfter=k1>; title="page 2"; rel=next`,
+			want: "https://api.meraki.com/api/v1/networks/N_1/clients?startingAfter=k1",
+		},
+		{
+			name: "relation only as a prefix",
+			link: `<https://api.meraki.com/api/v1/networks/N_1/clients?startingAfter=k1>; rel=nextpage`,
+			want: "",
+		},
+		{
+			name: "malformed part without parameters",
+			link: `<https://api.meraki.com/api/v1/networks/N_1/clients?startingAfter=k1>`,
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextPageURL(tt.link); got != tt.want {
+				t.Errorf("nextPageURL(%q) = %q, want %q", tt.link, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package meraki
+
+type SwitchAccessPolicyRadiusServer struct {
+	ServerID string `json:"serverId,omitempty"`
+	Host     string `json:"host"`
+	Port     int64  `json:"port,omitempty"`
+	Secret   string `json:"secret,omitempty"`
+}
+
+type SwitchAccessPolicy struct {
+	AccessPolicyNumber             FlexibleID                       `json:"accessPolicyNumber,omitempty"`
+	Name                           string                           `json:"name"`
+	RadiusServers                  []SwitchAccessPolicyRadiusServer `json:"radiusServers"`
+	RadiusTestingEnabled           bool                             `json:"radiusTestingEnabled"`
+	RadiusCoaSupportEnabled        bool                             `json:"radiusCoaSupportEnabled"`
+	RadiusAccountingEnabled        bool                             `json:"radiusAccountingEnabled"`
+	RadiusAccountingServers        []SwitchAccessPolicyRadiusServer `json:"radiusAccountingServers,omitempty"`
+	HostMode                       string                           `json:"hostMode"`
+	AccessPolicyType               string                           `json:"accessPolicyType,omitempty"`
+	GuestVlanID                    *int64                           `json:"guestVlanId"`
+	URLRedirectWalledGardenEnabled bool                             `json:"urlRedirectWalledGardenEnabled"`
+	URLRedirectWalledGardenRanges  []string                         `json:"urlRedirectWalledGardenRanges,omitempty"`
+}
+
+func (c *client) GetSwitchAccessPolicy(networkID string, accessPolicyNumber string) (*SwitchAccessPolicy, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/accessPolicies/" + accessPolicyNumber
+
+	var policy SwitchAccessPolicy
+	_, err := c.doRequest("GET", endpoint, nil, &policy)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (c *client) CreateSwitchAccessPolicy(networkID string, policy *SwitchAccessPolicy) (*SwitchAccessPolicy, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/accessPolicies"
+
+	var created SwitchAccessPolicy
+	_, err := c.doRequest("POST", endpoint, policy, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateSwitchAccessPolicy(networkID string, accessPolicyNumber string, policy *SwitchAccessPolicy) (*SwitchAccessPolicy, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/accessPolicies/" + accessPolicyNumber
+
+	var updated SwitchAccessPolicy
+	_, err := c.doRequest("PUT", endpoint, policy, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteSwitchAccessPolicy(networkID string, accessPolicyNumber string) error {
+	endpoint := base_url + "/networks/" + networkID + "/switch/accessPolicies/" + accessPolicyNumber
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
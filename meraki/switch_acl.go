@@ -0,0 +1,39 @@
+package meraki
+
+type SwitchACLRule struct {
+	Comment   string `json:"comment"`
+	Policy    string `json:"policy"`
+	IPVersion string `json:"ipVersion"`
+	Protocol  string `json:"protocol"`
+	SrcCidr   string `json:"srcCidr"`
+	SrcPort   string `json:"srcPort"`
+	DstCidr   string `json:"dstCidr"`
+	DstPort   string `json:"dstPort"`
+	Vlan      string `json:"vlan"`
+}
+
+type SwitchACL struct {
+	Rules []SwitchACLRule `json:"rules"`
+}
+
+func (c *client) GetSwitchACL(networkID string) (*SwitchACL, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/accessControlLists"
+
+	var acl SwitchACL
+	_, err := c.doRequest("GET", endpoint, nil, &acl)
+	if err != nil {
+		return nil, err
+	}
+	return &acl, nil
+}
+
+func (c *client) UpdateSwitchACL(networkID string, acl *SwitchACL) (*SwitchACL, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/accessControlLists"
+
+	var updated SwitchACL
+	_, err := c.doRequest("PUT", endpoint, acl, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,93 @@
+package meraki
+
+type SwitchDhcpServerPolicyEmailAlerts struct {
+	Enabled bool `json:"enabled"`
+}
+
+type SwitchDhcpServerPolicyAlerts struct {
+	Email SwitchDhcpServerPolicyEmailAlerts `json:"email"`
+}
+
+type SwitchDhcpServerPolicyArpInspection struct {
+	Enabled bool `json:"enabled"`
+}
+
+type SwitchDhcpServerPolicy struct {
+	DefaultPolicy  string                              `json:"defaultPolicy"`
+	AllowedServers []string                            `json:"allowedServers"`
+	BlockedServers []string                            `json:"blockedServers"`
+	Alerts         SwitchDhcpServerPolicyAlerts        `json:"alerts"`
+	ArpInspection  SwitchDhcpServerPolicyArpInspection `json:"arpInspection"`
+}
+
+type SwitchArpInspectionTrustedServerIPv4 struct {
+	Address string `json:"address"`
+}
+
+type SwitchArpInspectionTrustedServer struct {
+	TrustedServerID string                               `json:"trustedServerId,omitempty"`
+	Mac             string                               `json:"mac"`
+	Vlan            int64                                `json:"vlan"`
+	IPv4            SwitchArpInspectionTrustedServerIPv4 `json:"ipv4"`
+}
+
+func (c *client) GetSwitchDhcpServerPolicy(networkID string) (*SwitchDhcpServerPolicy, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dhcpServerPolicy"
+
+	var policy SwitchDhcpServerPolicy
+	_, err := c.doRequest("GET", endpoint, nil, &policy)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (c *client) UpdateSwitchDhcpServerPolicy(networkID string, policy *SwitchDhcpServerPolicy) (*SwitchDhcpServerPolicy, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dhcpServerPolicy"
+
+	var updated SwitchDhcpServerPolicy
+	_, err := c.doRequest("PUT", endpoint, policy, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetSwitchArpInspectionTrustedServers(networkID string) ([]SwitchArpInspectionTrustedServer, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dhcpServerPolicy/arpInspection/trustedServers"
+
+	var servers []SwitchArpInspectionTrustedServer
+	_, err := c.doRequest("GET", endpoint, nil, &servers)
+	if err != nil {
+		return nil, err
+	}
+	return servers, nil
+}
+
+func (c *client) CreateSwitchArpInspectionTrustedServer(networkID string, server *SwitchArpInspectionTrustedServer) (*SwitchArpInspectionTrustedServer, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dhcpServerPolicy/arpInspection/trustedServers"
+
+	var created SwitchArpInspectionTrustedServer
+	_, err := c.doRequest("POST", endpoint, server, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateSwitchArpInspectionTrustedServer(networkID string, trustedServerID string, server *SwitchArpInspectionTrustedServer) (*SwitchArpInspectionTrustedServer, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dhcpServerPolicy/arpInspection/trustedServers/" + trustedServerID
+
+	var updated SwitchArpInspectionTrustedServer
+	_, err := c.doRequest("PUT", endpoint, server, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteSwitchArpInspectionTrustedServer(networkID string, trustedServerID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dhcpServerPolicy/arpInspection/trustedServers/" + trustedServerID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
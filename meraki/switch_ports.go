@@ -0,0 +1,58 @@
+package meraki
+
+type SwitchPort struct {
+	PortID                  string   `json:"portId,omitempty"`
+	Name                    string   `json:"name"`
+	Tags                    []string `json:"tags"`
+	Enabled                 bool     `json:"enabled"`
+	PoeEnabled              bool     `json:"poeEnabled"`
+	Type                    string   `json:"type,omitempty"`
+	Vlan                    *int64   `json:"vlan,omitempty"`
+	VoiceVlan               *int64   `json:"voiceVlan"`
+	AllowedVlans            string   `json:"allowedVlans,omitempty"`
+	IsolationEnabled        bool     `json:"isolationEnabled"`
+	RstpEnabled             bool     `json:"rstpEnabled"`
+	StpGuard                string   `json:"stpGuard,omitempty"`
+	LinkNegotiation         string   `json:"linkNegotiation,omitempty"`
+	PortScheduleID          *string  `json:"portScheduleId"`
+	AccessPolicyType        string   `json:"accessPolicyType,omitempty"`
+	AccessPolicyNumber      *int64   `json:"accessPolicyNumber,omitempty"`
+	MacAllowList            []string `json:"macAllowList,omitempty"`
+	StickyMacAllowList      []string `json:"stickyMacAllowList,omitempty"`
+	StickyMacAllowListLimit *int64   `json:"stickyMacAllowListLimit,omitempty"`
+	StormControlEnabled     bool     `json:"stormControlEnabled"`
+	Udld                    string   `json:"udld,omitempty"`
+}
+
+func (c *client) GetSwitchPorts(serial string) ([]SwitchPort, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/ports"
+
+	var ports []SwitchPort
+	_, err := c.doRequest("GET", endpoint, nil, &ports)
+	if err != nil {
+		return nil, err
+	}
+	return ports, nil
+}
+
+func (c *client) GetSwitchPort(serial string, portID string) (*SwitchPort, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/ports/" + portID
+
+	var port SwitchPort
+	_, err := c.doRequest("GET", endpoint, nil, &port)
+	if err != nil {
+		return nil, err
+	}
+	return &port, nil
+}
+
+func (c *client) UpdateSwitchPort(serial string, portID string, port *SwitchPort) (*SwitchPort, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/ports/" + portID
+
+	var updated SwitchPort
+	_, err := c.doRequest("PUT", endpoint, port, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
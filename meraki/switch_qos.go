@@ -0,0 +1,98 @@
+package meraki
+
+type SwitchQosRule struct {
+	ID           string `json:"id,omitempty"`
+	Vlan         *int64 `json:"vlan"`
+	Protocol     string `json:"protocol,omitempty"`
+	SrcPort      *int64 `json:"srcPort,omitempty"`
+	SrcPortRange string `json:"srcPortRange,omitempty"`
+	DstPort      *int64 `json:"dstPort,omitempty"`
+	DstPortRange string `json:"dstPortRange,omitempty"`
+	Dscp         int64  `json:"dscp"`
+}
+
+type SwitchQosRulesOrder struct {
+	RuleIDs []string `json:"ruleIds"`
+}
+
+type SwitchDscpToCosMapping struct {
+	Dscp  int64  `json:"dscp"`
+	Cos   int64  `json:"cos"`
+	Title string `json:"title,omitempty"`
+}
+
+type SwitchDscpToCosMappings struct {
+	Mappings []SwitchDscpToCosMapping `json:"mappings"`
+}
+
+func (c *client) GetSwitchQosRules(networkID string) ([]SwitchQosRule, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/qosRules"
+
+	var rules []SwitchQosRule
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (c *client) CreateSwitchQosRule(networkID string, rule *SwitchQosRule) (*SwitchQosRule, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/qosRules"
+
+	var created SwitchQosRule
+	_, err := c.doRequest("POST", endpoint, rule, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateSwitchQosRule(networkID string, ruleID string, rule *SwitchQosRule) (*SwitchQosRule, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/qosRules/" + ruleID
+
+	var updated SwitchQosRule
+	_, err := c.doRequest("PUT", endpoint, rule, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteSwitchQosRule(networkID string, ruleID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/switch/qosRules/" + ruleID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
+
+func (c *client) UpdateSwitchQosRulesOrder(networkID string, order *SwitchQosRulesOrder) (*SwitchQosRulesOrder, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/qosRules/order"
+
+	var updated SwitchQosRulesOrder
+	_, err := c.doRequest("PUT", endpoint, order, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetSwitchDscpToCosMappings(networkID string) (*SwitchDscpToCosMappings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dscpToCosMappings"
+
+	var mappings SwitchDscpToCosMappings
+	_, err := c.doRequest("GET", endpoint, nil, &mappings)
+	if err != nil {
+		return nil, err
+	}
+	return &mappings, nil
+}
+
+func (c *client) UpdateSwitchDscpToCosMappings(networkID string, mappings *SwitchDscpToCosMappings) (*SwitchDscpToCosMappings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/dscpToCosMappings"
+
+	var updated SwitchDscpToCosMappings
+	_, err := c.doRequest("PUT", endpoint, mappings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
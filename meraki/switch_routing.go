@@ -0,0 +1,227 @@
+package meraki
+
+type SwitchRoutingInterface struct {
+	InterfaceID      string                              `json:"interfaceId,omitempty"`
+	Name             string                              `json:"name"`
+	Subnet           string                              `json:"subnet"`
+	InterfaceIP      string                              `json:"interfaceIp"`
+	VlanID           int64                               `json:"vlanId"`
+	DefaultGateway   string                              `json:"defaultGateway,omitempty"`
+	MulticastRouting string                              `json:"multicastRouting,omitempty"`
+	OspfSettings     *SwitchRoutingInterfaceOspfSettings `json:"ospfSettings,omitempty"`
+}
+
+// SwitchRoutingInterfaceOspfSettings are the OSPF settings of a routing interface, an area of
+// "disabled" turns OSPF off on the interface.
+type SwitchRoutingInterfaceOspfSettings struct {
+	Area             string `json:"area"`
+	Cost             int64  `json:"cost"`
+	IsPassiveEnabled bool   `json:"isPassiveEnabled"`
+}
+
+type SwitchStaticRoute struct {
+	StaticRouteID               string `json:"staticRouteId,omitempty"`
+	Name                        string `json:"name"`
+	Subnet                      string `json:"subnet"`
+	NextHopIP                   string `json:"nextHopIp"`
+	AdvertiseViaOspfEnabled     bool   `json:"advertiseViaOspfEnabled"`
+	PreferOverOspfRoutesEnabled bool   `json:"preferOverOspfRoutesEnabled"`
+}
+
+type SwitchOspfArea struct {
+	AreaID   FlexibleID `json:"areaId"`
+	AreaName string     `json:"areaName"`
+	AreaType string     `json:"areaType"`
+}
+
+type SwitchOspfMd5AuthenticationKey struct {
+	ID         int64  `json:"id"`
+	Passphrase string `json:"passphrase"`
+}
+
+type SwitchOspfSettings struct {
+	Enabled                  bool                            `json:"enabled"`
+	HelloTimerInSeconds      int64                           `json:"helloTimerInSeconds"`
+	DeadTimerInSeconds       int64                           `json:"deadTimerInSeconds"`
+	Areas                    []SwitchOspfArea                `json:"areas,omitempty"`
+	Md5AuthenticationEnabled bool                            `json:"md5AuthenticationEnabled"`
+	Md5AuthenticationKey     *SwitchOspfMd5AuthenticationKey `json:"md5AuthenticationKey,omitempty"`
+}
+
+func (c *client) GetSwitchRoutingInterface(serial string, interfaceID string) (*SwitchRoutingInterface, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/interfaces/" + interfaceID
+
+	var routingInterface SwitchRoutingInterface
+	_, err := c.doRequest("GET", endpoint, nil, &routingInterface)
+	if err != nil {
+		return nil, err
+	}
+	return &routingInterface, nil
+}
+
+func (c *client) CreateSwitchRoutingInterface(serial string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/interfaces"
+
+	var created SwitchRoutingInterface
+	_, err := c.doRequest("POST", endpoint, routingInterface, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateSwitchRoutingInterface(serial string, interfaceID string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/interfaces/" + interfaceID
+
+	var updated SwitchRoutingInterface
+	_, err := c.doRequest("PUT", endpoint, routingInterface, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteSwitchRoutingInterface(serial string, interfaceID string) error {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/interfaces/" + interfaceID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
+
+func (c *client) GetSwitchStaticRoute(serial string, routeID string) (*SwitchStaticRoute, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/staticRoutes/" + routeID
+
+	var route SwitchStaticRoute
+	_, err := c.doRequest("GET", endpoint, nil, &route)
+	if err != nil {
+		return nil, err
+	}
+	return &route, nil
+}
+
+func (c *client) CreateSwitchStaticRoute(serial string, route *SwitchStaticRoute) (*SwitchStaticRoute, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/staticRoutes"
+
+	var created SwitchStaticRoute
+	_, err := c.doRequest("POST", endpoint, route, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateSwitchStaticRoute(serial string, routeID string, route *SwitchStaticRoute) (*SwitchStaticRoute, error) {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/staticRoutes/" + routeID
+
+	var updated SwitchStaticRoute
+	_, err := c.doRequest("PUT", endpoint, route, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteSwitchStaticRoute(serial string, routeID string) error {
+	endpoint := base_url + "/devices/" + serial + "/switch/routing/staticRoutes/" + routeID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
+
+func (c *client) GetSwitchOspfSettings(networkID string) (*SwitchOspfSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/routing/ospf"
+
+	var settings SwitchOspfSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateSwitchOspfSettings(networkID string, settings *SwitchOspfSettings) (*SwitchOspfSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/routing/ospf"
+
+	var updated SwitchOspfSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetSwitchStackRoutingInterface(networkID string, stackID string, interfaceID string) (*SwitchRoutingInterface, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/interfaces/" + interfaceID
+
+	var routingInterface SwitchRoutingInterface
+	_, err := c.doRequest("GET", endpoint, nil, &routingInterface)
+	if err != nil {
+		return nil, err
+	}
+	return &routingInterface, nil
+}
+
+func (c *client) CreateSwitchStackRoutingInterface(networkID string, stackID string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/interfaces"
+
+	var created SwitchRoutingInterface
+	_, err := c.doRequest("POST", endpoint, routingInterface, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateSwitchStackRoutingInterface(networkID string, stackID string, interfaceID string, routingInterface *SwitchRoutingInterface) (*SwitchRoutingInterface, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/interfaces/" + interfaceID
+
+	var updated SwitchRoutingInterface
+	_, err := c.doRequest("PUT", endpoint, routingInterface, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteSwitchStackRoutingInterface(networkID string, stackID string, interfaceID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/interfaces/" + interfaceID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
+
+func (c *client) GetSwitchStackStaticRoute(networkID string, stackID string, routeID string) (*SwitchStaticRoute, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/staticRoutes/" + routeID
+
+	var route SwitchStaticRoute
+	_, err := c.doRequest("GET", endpoint, nil, &route)
+	if err != nil {
+		return nil, err
+	}
+	return &route, nil
+}
+
+func (c *client) CreateSwitchStackStaticRoute(networkID string, stackID string, route *SwitchStaticRoute) (*SwitchStaticRoute, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/staticRoutes"
+
+	var created SwitchStaticRoute
+	_, err := c.doRequest("POST", endpoint, route, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateSwitchStackStaticRoute(networkID string, stackID string, routeID string, route *SwitchStaticRoute) (*SwitchStaticRoute, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/staticRoutes/" + routeID
+
+	var updated SwitchStaticRoute
+	_, err := c.doRequest("PUT", endpoint, route, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteSwitchStackStaticRoute(networkID string, stackID string, routeID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/routing/staticRoutes/" + routeID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
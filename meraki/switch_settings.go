@@ -0,0 +1,131 @@
+package meraki
+
+type SwitchStpBridgePriority struct {
+	Switches       []string `json:"switches,omitempty"`
+	Stacks         []string `json:"stacks,omitempty"`
+	SwitchProfiles []string `json:"switchProfiles,omitempty"`
+	StpPriority    int64    `json:"stpPriority"`
+}
+
+type SwitchStpSettings struct {
+	RstpEnabled       bool                      `json:"rstpEnabled"`
+	StpBridgePriority []SwitchStpBridgePriority `json:"stpBridgePriority"`
+}
+
+type SwitchMtuOverride struct {
+	Switches       []string `json:"switches,omitempty"`
+	SwitchProfiles []string `json:"switchProfiles,omitempty"`
+	MtuSize        int64    `json:"mtuSize"`
+}
+
+type SwitchMtu struct {
+	DefaultMtuSize int64               `json:"defaultMtuSize"`
+	Overrides      []SwitchMtuOverride `json:"overrides"`
+}
+
+// SwitchStormControl holds the storm control thresholds as a percentage of the link speed,
+// a threshold of 100 disables storm control for the traffic type.
+type SwitchStormControl struct {
+	BroadcastThreshold      *int64 `json:"broadcastThreshold,omitempty"`
+	MulticastThreshold      *int64 `json:"multicastThreshold,omitempty"`
+	UnknownUnicastThreshold *int64 `json:"unknownUnicastThreshold,omitempty"`
+}
+
+type SwitchPowerException struct {
+	Serial    string `json:"serial"`
+	PowerType string `json:"powerType"`
+}
+
+type SwitchSettings struct {
+	Vlan             int64                  `json:"vlan"`
+	UseCombinedPower bool                   `json:"useCombinedPower"`
+	PowerExceptions  []SwitchPowerException `json:"powerExceptions"`
+}
+
+func (c *client) GetSwitchStpSettings(networkID string) (*SwitchStpSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stp"
+
+	var settings SwitchStpSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateSwitchStpSettings(networkID string, settings *SwitchStpSettings) (*SwitchStpSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stp"
+
+	var updated SwitchStpSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetSwitchMtu(networkID string) (*SwitchMtu, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/mtu"
+
+	var mtu SwitchMtu
+	_, err := c.doRequest("GET", endpoint, nil, &mtu)
+	if err != nil {
+		return nil, err
+	}
+	return &mtu, nil
+}
+
+func (c *client) UpdateSwitchMtu(networkID string, mtu *SwitchMtu) (*SwitchMtu, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/mtu"
+
+	var updated SwitchMtu
+	_, err := c.doRequest("PUT", endpoint, mtu, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetSwitchStormControl(networkID string) (*SwitchStormControl, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stormControl"
+
+	var stormControl SwitchStormControl
+	_, err := c.doRequest("GET", endpoint, nil, &stormControl)
+	if err != nil {
+		return nil, err
+	}
+	return &stormControl, nil
+}
+
+func (c *client) UpdateSwitchStormControl(networkID string, stormControl *SwitchStormControl) (*SwitchStormControl, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stormControl"
+
+	var updated SwitchStormControl
+	_, err := c.doRequest("PUT", endpoint, stormControl, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetSwitchSettings(networkID string) (*SwitchSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/settings"
+
+	var settings SwitchSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateSwitchSettings(networkID string, settings *SwitchSettings) (*SwitchSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/settings"
+
+	var updated SwitchSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
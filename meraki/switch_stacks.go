@@ -0,0 +1,72 @@
+package meraki
+
+type SwitchStack struct {
+	ID      string   `json:"id,omitempty"`
+	Name    string   `json:"name"`
+	Serials []string `json:"serials"`
+}
+
+type SwitchStackMemberRequest struct {
+	Serial string `json:"serial"`
+}
+
+func (c *client) GetSwitchStacks(networkID string) ([]SwitchStack, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks"
+
+	var stacks []SwitchStack
+	_, err := c.doRequest("GET", endpoint, nil, &stacks)
+	if err != nil {
+		return nil, err
+	}
+	return stacks, nil
+}
+
+func (c *client) GetSwitchStack(networkID string, stackID string) (*SwitchStack, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID
+
+	var stack SwitchStack
+	_, err := c.doRequest("GET", endpoint, nil, &stack)
+	if err != nil {
+		return nil, err
+	}
+	return &stack, nil
+}
+
+func (c *client) CreateSwitchStack(networkID string, stack *SwitchStack) (*SwitchStack, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks"
+
+	var created SwitchStack
+	_, err := c.doRequest("POST", endpoint, stack, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) DeleteSwitchStack(networkID string, stackID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
+
+func (c *client) AddSwitchStackMember(networkID string, stackID string, serial string) (*SwitchStack, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/add"
+
+	var updated SwitchStack
+	_, err := c.doRequest("POST", endpoint, &SwitchStackMemberRequest{Serial: serial}, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) RemoveSwitchStackMember(networkID string, stackID string, serial string) (*SwitchStack, error) {
+	endpoint := base_url + "/networks/" + networkID + "/switch/stacks/" + stackID + "/remove"
+
+	var updated SwitchStack
+	_, err := c.doRequest("POST", endpoint, &SwitchStackMemberRequest{Serial: serial}, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,74 @@
+package meraki
+
+type WirelessRfProfileBandSettings struct {
+	BandOperationMode   string `json:"bandOperationMode,omitempty"`
+	BandSteeringEnabled *bool  `json:"bandSteeringEnabled,omitempty"`
+}
+
+type WirelessRfProfileSsidSettings struct {
+	MinBitrate          float64 `json:"minBitrate,omitempty"`
+	BandOperationMode   string  `json:"bandOperationMode,omitempty"`
+	BandSteeringEnabled *bool   `json:"bandSteeringEnabled,omitempty"`
+}
+
+type WirelessRfProfileRadioSettings struct {
+	MaxPower          int64   `json:"maxPower,omitempty"`
+	MinPower          int64   `json:"minPower,omitempty"`
+	MinBitrate        float64 `json:"minBitrate,omitempty"`
+	ValidAutoChannels []int64 `json:"validAutoChannels,omitempty"`
+	ChannelWidth      string  `json:"channelWidth,omitempty"`
+	AxEnabled         *bool   `json:"axEnabled,omitempty"`
+}
+
+type WirelessRfProfile struct {
+	ID                     string                                   `json:"id,omitempty"`
+	NetworkID              string                                   `json:"networkId,omitempty"`
+	Name                   string                                   `json:"name"`
+	BandSelectionType      string                                   `json:"bandSelectionType"`
+	ClientBalancingEnabled *bool                                    `json:"clientBalancingEnabled,omitempty"`
+	MinBitrateType         string                                   `json:"minBitrateType,omitempty"`
+	ApBandSettings         *WirelessRfProfileBandSettings           `json:"apBandSettings,omitempty"`
+	TwoFourGhzSettings     *WirelessRfProfileRadioSettings          `json:"twoFourGhzSettings,omitempty"`
+	FiveGhzSettings        *WirelessRfProfileRadioSettings          `json:"fiveGhzSettings,omitempty"`
+	SixGhzSettings         *WirelessRfProfileRadioSettings          `json:"sixGhzSettings,omitempty"`
+	PerSsidSettings        map[string]WirelessRfProfileSsidSettings `json:"perSsidSettings,omitempty"`
+}
+
+func (c *client) GetWirelessRfProfile(networkID string, rfProfileID string) (*WirelessRfProfile, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/rfProfiles/" + rfProfileID
+
+	var profile WirelessRfProfile
+	_, err := c.doRequest("GET", endpoint, nil, &profile)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (c *client) CreateWirelessRfProfile(networkID string, profile *WirelessRfProfile) (*WirelessRfProfile, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/rfProfiles"
+
+	var created WirelessRfProfile
+	_, err := c.doRequest("POST", endpoint, profile, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *client) UpdateWirelessRfProfile(networkID string, rfProfileID string, profile *WirelessRfProfile) (*WirelessRfProfile, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/rfProfiles/" + rfProfileID
+
+	var updated WirelessRfProfile
+	_, err := c.doRequest("PUT", endpoint, profile, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) DeleteWirelessRfProfile(networkID string, rfProfileID string) error {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/rfProfiles/" + rfProfileID
+	_, err := c.doRequest("DELETE", endpoint, nil, nil)
+	return err
+}
@@ -0,0 +1,90 @@
+package meraki
+
+type WirelessSettings struct {
+	MeshingEnabled           bool   `json:"meshingEnabled"`
+	Ipv6BridgeEnabled        bool   `json:"ipv6BridgeEnabled"`
+	LocationAnalyticsEnabled bool   `json:"locationAnalyticsEnabled"`
+	UpgradeStrategy          string `json:"upgradeStrategy,omitempty"`
+	LedLightsOn              bool   `json:"ledLightsOn"`
+}
+
+type WirelessBluetoothSettings struct {
+	ScanningEnabled          bool   `json:"scanningEnabled"`
+	AdvertisingEnabled       bool   `json:"advertisingEnabled"`
+	UUID                     string `json:"uuid,omitempty"`
+	MajorMinorAssignmentMode string `json:"majorMinorAssignmentMode,omitempty"`
+	Major                    *int64 `json:"major,omitempty"`
+	Minor                    *int64 `json:"minor,omitempty"`
+}
+
+type WirelessDeviceBluetoothSettings struct {
+	UUID  string `json:"uuid,omitempty"`
+	Major *int64 `json:"major,omitempty"`
+	Minor *int64 `json:"minor,omitempty"`
+}
+
+func (c *client) GetWirelessSettings(networkID string) (*WirelessSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/settings"
+
+	var settings WirelessSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateWirelessSettings(networkID string, settings *WirelessSettings) (*WirelessSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/settings"
+
+	var updated WirelessSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetWirelessBluetoothSettings(networkID string) (*WirelessBluetoothSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/bluetooth/settings"
+
+	var settings WirelessBluetoothSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateWirelessBluetoothSettings(networkID string, settings *WirelessBluetoothSettings) (*WirelessBluetoothSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/bluetooth/settings"
+
+	var updated WirelessBluetoothSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetWirelessDeviceBluetoothSettings(serial string) (*WirelessDeviceBluetoothSettings, error) {
+	endpoint := base_url + "/devices/" + serial + "/wireless/bluetooth/settings"
+
+	var settings WirelessDeviceBluetoothSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateWirelessDeviceBluetoothSettings(serial string, settings *WirelessDeviceBluetoothSettings) (*WirelessDeviceBluetoothSettings, error) {
+	endpoint := base_url + "/devices/" + serial + "/wireless/bluetooth/settings"
+
+	var updated WirelessDeviceBluetoothSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
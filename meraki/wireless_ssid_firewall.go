@@ -0,0 +1,66 @@
+package meraki
+
+type WirelessSSIDFirewallRule struct {
+	Comment  string `json:"comment"`
+	Policy   string `json:"policy"`
+	Protocol string `json:"protocol"`
+	DestPort string `json:"destPort,omitempty"`
+	DestCidr string `json:"destCidr"`
+}
+
+// WirelessSSIDL3FirewallRules are the L3 firewall rules of an SSID. AllowLanAccess is only accepted
+// on update, the API reports it as a "Local LAN" rule before the default rule instead.
+type WirelessSSIDL3FirewallRules struct {
+	Rules          []WirelessSSIDFirewallRule `json:"rules"`
+	AllowLanAccess *bool                      `json:"allowLanAccess,omitempty"`
+}
+
+// WirelessSSIDL7FirewallRules are the L7 firewall rules of an SSID, which use the same rule
+// encoding as the appliance, without the country rules.
+type WirelessSSIDL7FirewallRules struct {
+	Rules []ApplianceL7FirewallRule `json:"rules"`
+}
+
+func (c *client) GetWirelessSSIDL3FirewallRules(networkID string, number string) (*WirelessSSIDL3FirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/firewall/l3FirewallRules"
+
+	var rules WirelessSSIDL3FirewallRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateWirelessSSIDL3FirewallRules(networkID string, number string, rules *WirelessSSIDL3FirewallRules) (*WirelessSSIDL3FirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/firewall/l3FirewallRules"
+
+	var updated WirelessSSIDL3FirewallRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetWirelessSSIDL7FirewallRules(networkID string, number string) (*WirelessSSIDL7FirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/firewall/l7FirewallRules"
+
+	var rules WirelessSSIDL7FirewallRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateWirelessSSIDL7FirewallRules(networkID string, number string, rules *WirelessSSIDL7FirewallRules) (*WirelessSSIDL7FirewallRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/firewall/l7FirewallRules"
+
+	var updated WirelessSSIDL7FirewallRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -0,0 +1,114 @@
+package meraki
+
+type WirelessSSIDSplashImage struct {
+	Contents string `json:"contents"`
+	Format   string `json:"format"`
+}
+
+// WirelessSSIDSplashLogo is returned with the MD5 of the current logo, and is uploaded as Image.
+type WirelessSSIDSplashLogo struct {
+	MD5       string                   `json:"md5,omitempty"`
+	Extension string                   `json:"extension,omitempty"`
+	Image     *WirelessSSIDSplashImage `json:"image,omitempty"`
+}
+
+type WirelessSSIDGuestSponsorship struct {
+	DurationInMinutes        int64 `json:"durationInMinutes"`
+	GuestCanRequestTimeframe bool  `json:"guestCanRequestTimeframe"`
+}
+
+type WirelessSSIDBillingFreeAccess struct {
+	Enabled           bool  `json:"enabled"`
+	DurationInMinutes int64 `json:"durationInMinutes,omitempty"`
+}
+
+type WirelessSSIDBilling struct {
+	FreeAccess                    *WirelessSSIDBillingFreeAccess `json:"freeAccess,omitempty"`
+	PrepaidAccessFastLoginEnabled bool                           `json:"prepaidAccessFastLoginEnabled"`
+	ReplyToEmailAddress           string                         `json:"replyToEmailAddress,omitempty"`
+}
+
+type WirelessSSIDSplashSettings struct {
+	SplashURL                   string                        `json:"splashUrl,omitempty"`
+	UseSplashURL                bool                          `json:"useSplashUrl"`
+	RedirectURL                 string                        `json:"redirectUrl,omitempty"`
+	UseRedirectURL              bool                          `json:"useRedirectUrl"`
+	WelcomeMessage              string                        `json:"welcomeMessage"`
+	SplashTimeout               int64                         `json:"splashTimeout,omitempty"`
+	BlockAllTrafficBeforeSignOn bool                          `json:"blockAllTrafficBeforeSignOn"`
+	ThemeID                     string                        `json:"themeId,omitempty"`
+	SplashLogo                  *WirelessSSIDSplashLogo       `json:"splashLogo,omitempty"`
+	GuestSponsorship            *WirelessSSIDGuestSponsorship `json:"guestSponsorship,omitempty"`
+	Billing                     *WirelessSSIDBilling          `json:"billing,omitempty"`
+}
+
+type WirelessSSIDSplashAuthorization struct {
+	IsAuthorized bool   `json:"isAuthorized"`
+	AuthorizedAt string `json:"authorizedAt,omitempty"`
+	ExpiresAt    string `json:"expiresAt,omitempty"`
+}
+
+// WirelessClientSplashAuthorizationStatus holds the splash authorization of a client keyed by SSID number.
+type WirelessClientSplashAuthorizationStatus struct {
+	SSIDs map[string]WirelessSSIDSplashAuthorization `json:"ssids"`
+}
+
+func (c *client) GetWirelessSSIDSplashSettings(networkID string, number string) (*WirelessSSIDSplashSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/splash/settings"
+
+	var settings WirelessSSIDSplashSettings
+	_, err := c.doRequest("GET", endpoint, nil, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (c *client) UpdateWirelessSSIDSplashSettings(networkID string, number string, settings *WirelessSSIDSplashSettings) (*WirelessSSIDSplashSettings, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/splash/settings"
+
+	var updated WirelessSSIDSplashSettings
+	_, err := c.doRequest("PUT", endpoint, settings, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// UpdateWirelessSSIDSplashGuestSponsorDomains sets the email domains of the sponsors of a
+// sponsored guest splash page, which are part of the SSID instead of its splash settings.
+func (c *client) UpdateWirelessSSIDSplashGuestSponsorDomains(networkID string, number string, domains []string) (*WirelessSSID, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number
+
+	body := struct {
+		SplashGuestSponsorDomains []string `json:"splashGuestSponsorDomains"`
+	}{domains}
+	var updated WirelessSSID
+	_, err := c.doRequest("PUT", endpoint, body, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (c *client) GetWirelessClientSplashAuthorizationStatus(networkID string, clientID string) (*WirelessClientSplashAuthorizationStatus, error) {
+	endpoint := base_url + "/networks/" + networkID + "/clients/" + clientID + "/splashAuthorizationStatus"
+
+	var status WirelessClientSplashAuthorizationStatus
+	_, err := c.doRequest("GET", endpoint, nil, &status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func (c *client) UpdateWirelessClientSplashAuthorizationStatus(networkID string, clientID string, status *WirelessClientSplashAuthorizationStatus) (*WirelessClientSplashAuthorizationStatus, error) {
+	endpoint := base_url + "/networks/" + networkID + "/clients/" + clientID + "/splashAuthorizationStatus"
+
+	var updated WirelessClientSplashAuthorizationStatus
+	_, err := c.doRequest("PUT", endpoint, status, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
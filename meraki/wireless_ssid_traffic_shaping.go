@@ -0,0 +1,36 @@
+package meraki
+
+type WirelessSSIDTrafficShapingRule struct {
+	Definitions              []ApplianceTrafficShapingDefinition              `json:"definitions"`
+	PerClientBandwidthLimits *ApplianceTrafficShapingPerClientBandwidthLimits `json:"perClientBandwidthLimits,omitempty"`
+	DscpTagValue             *int64                                           `json:"dscpTagValue"`
+	PcpTagValue              *int64                                           `json:"pcpTagValue"`
+}
+
+type WirelessSSIDTrafficShapingRules struct {
+	TrafficShapingEnabled bool                             `json:"trafficShapingEnabled"`
+	DefaultRulesEnabled   bool                             `json:"defaultRulesEnabled"`
+	Rules                 []WirelessSSIDTrafficShapingRule `json:"rules"`
+}
+
+func (c *client) GetWirelessSSIDTrafficShapingRules(networkID string, number string) (*WirelessSSIDTrafficShapingRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/trafficShaping/rules"
+
+	var rules WirelessSSIDTrafficShapingRules
+	_, err := c.doRequest("GET", endpoint, nil, &rules)
+	if err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+func (c *client) UpdateWirelessSSIDTrafficShapingRules(networkID string, number string, rules *WirelessSSIDTrafficShapingRules) (*WirelessSSIDTrafficShapingRules, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number + "/trafficShaping/rules"
+
+	var updated WirelessSSIDTrafficShapingRules
+	_, err := c.doRequest("PUT", endpoint, rules, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
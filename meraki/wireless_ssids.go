@@ -0,0 +1,51 @@
+package meraki
+
+type WirelessSSIDRadiusServer struct {
+	Host   string `json:"host"`
+	Port   int64  `json:"port,omitempty"`
+	Secret string `json:"secret,omitempty"`
+}
+
+type WirelessSSID struct {
+	Number                      int64                      `json:"number,omitempty"`
+	Name                        string                     `json:"name"`
+	Enabled                     bool                       `json:"enabled"`
+	AuthMode                    string                     `json:"authMode,omitempty"`
+	EncryptionMode              string                     `json:"encryptionMode,omitempty"`
+	WpaEncryptionMode           string                     `json:"wpaEncryptionMode,omitempty"`
+	PSK                         string                     `json:"psk,omitempty"`
+	RadiusServers               []WirelessSSIDRadiusServer `json:"radiusServers,omitempty"`
+	RadiusAccountingEnabled     *bool                      `json:"radiusAccountingEnabled,omitempty"`
+	RadiusAccountingServers     []WirelessSSIDRadiusServer `json:"radiusAccountingServers,omitempty"`
+	IPAssignmentMode            string                     `json:"ipAssignmentMode,omitempty"`
+	UseVlanTagging              *bool                      `json:"useVlanTagging,omitempty"`
+	DefaultVlanID               *int64                     `json:"defaultVlanId,omitempty"`
+	BandSelection               string                     `json:"bandSelection,omitempty"`
+	MinBitrate                  float64                    `json:"minBitrate,omitempty"`
+	Visible                     *bool                      `json:"visible,omitempty"`
+	PerClientBandwidthLimitUp   int64                      `json:"perClientBandwidthLimitUp"`
+	PerClientBandwidthLimitDown int64                      `json:"perClientBandwidthLimitDown"`
+	SplashGuestSponsorDomains   []string                   `json:"splashGuestSponsorDomains,omitempty"`
+}
+
+func (c *client) GetWirelessSSID(networkID string, number string) (*WirelessSSID, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number
+
+	var ssid WirelessSSID
+	_, err := c.doRequest("GET", endpoint, nil, &ssid)
+	if err != nil {
+		return nil, err
+	}
+	return &ssid, nil
+}
+
+func (c *client) UpdateWirelessSSID(networkID string, number string, ssid *WirelessSSID) (*WirelessSSID, error) {
+	endpoint := base_url + "/networks/" + networkID + "/wireless/ssids/" + number
+
+	var updated WirelessSSID
+	_, err := c.doRequest("PUT", endpoint, ssid, &updated)
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}